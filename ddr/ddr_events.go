@@ -0,0 +1,176 @@
+package ddr
+
+import (
+	"fmt"
+	"github.com/PuerkitoBio/goquery"
+	"github.com/chris-sg/eagate/util"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// GoldenLeague is the player's standing in the current Golden League
+// period.
+type GoldenLeague struct {
+	Class          string
+	Score          int
+	PromotionScore int
+	DemotionScore  int
+	PeriodStart    time.Time
+	PeriodEnd      time.Time
+
+	PlayerCode int
+}
+
+// ScoreToPromotion returns the score still needed to be promoted, or 0
+// once the promotion threshold has been reached.
+func (league GoldenLeague) ScoreToPromotion() int {
+	if league.Score >= league.PromotionScore {
+		return 0
+	}
+	return league.PromotionScore - league.Score
+}
+
+// EventProgress is the player's progress through a time-limited event.
+type EventProgress struct {
+	EventId       string
+	Name          string
+	UnlockedSongs []string
+	LockedSongs   []string
+	Requirements  []EventRequirement
+
+	PlayerCode int
+}
+
+// EventRequirement is a single unlock condition of an event, such as a
+// number of plays or points.
+type EventRequirement struct {
+	Description string
+	Current     int
+	Target      int
+}
+
+// Remaining returns how much is left before the requirement is met.
+func (requirement EventRequirement) Remaining() int {
+	if requirement.Current >= requirement.Target {
+		return 0
+	}
+	return requirement.Target - requirement.Current
+}
+
+// goldenLeagueForClient will load the golden league standing for a
+// client. The page URL and layout have not been checked against a
+// captured page, so this stays unexported until they are.
+func goldenLeagueForClient(client util.EaClient, playerCode int) (league GoldenLeague, err error) {
+	document, err := goldenLeagueDocument(client)
+	if err != nil {
+		return
+	}
+	league, err = goldenLeagueFromDocument(document, playerCode)
+	return
+}
+
+func goldenLeagueFromDocument(document *goquery.Document, playerCode int) (league GoldenLeague, err error) {
+	tables := document.Find("div#golden_league table")
+	if tables.Length() == 0 {
+		err = fmt.Errorf("cannot find golden league table")
+		return
+	}
+
+	details := make(map[string]string)
+	tables.Each(func(i int, s *goquery.Selection) {
+		tableDetails, err := util.TableThTd(s)
+		if err != nil {
+			return
+		}
+		for k, v := range tableDetails {
+			details[k] = v
+		}
+	})
+
+	numericalStripper, _ := regexp.Compile("[^0-9]+")
+	league.Class = details["所属クラス"]
+	league.Score, _ = strconv.Atoi(numericalStripper.ReplaceAllString(details["スコア"], ""))
+	league.PromotionScore, _ = strconv.Atoi(numericalStripper.ReplaceAllString(details["昇格ライン"], ""))
+	league.DemotionScore, _ = strconv.Atoi(numericalStripper.ReplaceAllString(details["降格ライン"], ""))
+	league.PeriodStart, league.PeriodEnd, err = periodFromString(details["開催期間"])
+	league.PlayerCode = playerCode
+	return
+}
+
+// periodFromString will parse a period such as
+// "2020-05-01 10:00～2020-05-31 23:59" in Japan time.
+func periodFromString(period string) (start time.Time, end time.Time, err error) {
+	timeFormat := "2006-01-02 15:04"
+	timeLocation, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		return
+	}
+
+	dateExp := regexp.MustCompile("[0-9]{4}[-/][0-9]{2}[-/][0-9]{2} [0-9]{2}:[0-9]{2}")
+	dates := dateExp.FindAllString(period, 2)
+	if len(dates) != 2 {
+		err = fmt.Errorf("cannot parse period %s", period)
+		return
+	}
+	start, err = time.ParseInLocation(timeFormat, strings.Replace(dates[0], "/", "-", -1), timeLocation)
+	if err != nil {
+		return
+	}
+	end, err = time.ParseInLocation(timeFormat, strings.Replace(dates[1], "/", "-", -1), timeLocation)
+	return
+}
+
+// eventProgressForClient will load the progress of an event for a
+// client. The page URL and layout have not been checked against a
+// captured page, so this stays unexported until they are.
+func eventProgressForClient(client util.EaClient, eventId string, playerCode int) (progress EventProgress, err error) {
+	document, err := eventDocument(client, eventId)
+	if err != nil {
+		return
+	}
+	progress, err = eventProgressFromDocument(document, eventId, playerCode)
+	return
+}
+
+func eventProgressFromDocument(document *goquery.Document, eventId string, playerCode int) (progress EventProgress, err error) {
+	event := document.Find("div#event_progress").First()
+	if event.Length() == 0 {
+		err = fmt.Errorf("cannot find event progress for %s", eventId)
+		return
+	}
+
+	progress.EventId = eventId
+	progress.Name = strings.TrimSpace(event.Find("h2, .event_name").First().Text())
+	progress.PlayerCode = playerCode
+
+	event.Find("ul.music_list li").Each(func(i int, s *goquery.Selection) {
+		title := strings.TrimSpace(s.Text())
+		if title == "" {
+			return
+		}
+		if s.HasClass("unlock") {
+			progress.UnlockedSongs = append(progress.UnlockedSongs, title)
+		} else {
+			progress.LockedSongs = append(progress.LockedSongs, title)
+		}
+	})
+
+	progressExp := regexp.MustCompile("([0-9,]+)\\s*/\\s*([0-9,]+)")
+	event.Find("table.requirement tr").Each(func(i int, s *goquery.Selection) {
+		description := strings.TrimSpace(s.Find("th").First().Text())
+		match := progressExp.FindStringSubmatch(s.Find("td").First().Text())
+		if description == "" || match == nil {
+			return
+		}
+		current, _ := strconv.Atoi(strings.Replace(match[1], ",", "", -1))
+		target, _ := strconv.Atoi(strings.Replace(match[2], ",", "", -1))
+		progress.Requirements = append(progress.Requirements, EventRequirement{
+			Description: description,
+			Current:     current,
+			Target:      target,
+		})
+	})
+	return
+}
@@ -0,0 +1,82 @@
+package ddr
+
+import (
+	"testing"
+)
+
+func TestGoldenLeagueFromDocument(t *testing.T) {
+	// Setup test
+	const testFile = "./test_data/event/golden_league.html"
+
+	// Run Test
+	document, err := documentFromFile(testFile)
+	if err != nil {
+		t.Fatalf("could not load %s: %s", testFile, err.Error())
+	}
+
+	league, err := goldenLeagueFromDocument(document, 12345678)
+	if err != nil {
+		t.Fatalf("error in goldenLeagueFromDocument: %s", err.Error())
+	}
+	if league.Class != "シルバー" ||
+		league.Score != 1234560 ||
+		league.PromotionScore != 1500000 ||
+		league.DemotionScore != 800000 ||
+		league.PlayerCode != 12345678 {
+		t.Errorf("golden league did not match, got %+#v", league)
+	}
+	if league.PeriodStart.Format("2006-01-02 15:04") != "2020-05-01 10:00" ||
+		league.PeriodEnd.Format("2006-01-02 15:04") != "2020-05-31 23:59" {
+		t.Errorf("golden league period did not match, got %s to %s", league.PeriodStart, league.PeriodEnd)
+	}
+	if league.ScoreToPromotion() != 265440 {
+		t.Errorf("expected 265440 to promotion, got %d", league.ScoreToPromotion())
+	}
+}
+
+func TestEventProgressFromDocument(t *testing.T) {
+	// Setup test
+	const testFile = "./test_data/event/extra_savior.html"
+
+	// Run Test
+	document, err := documentFromFile(testFile)
+	if err != nil {
+		t.Fatalf("could not load %s: %s", testFile, err.Error())
+	}
+
+	progress, err := eventProgressFromDocument(document, "extra_savior", 12345678)
+	if err != nil {
+		t.Fatalf("error in eventProgressFromDocument: %s", err.Error())
+	}
+	if progress.Name != "EXTRA SAVIOR" ||
+		len(progress.UnlockedSongs) != 2 ||
+		len(progress.LockedSongs) != 1 ||
+		progress.LockedSongs[0] != "Draw the Savage" {
+		t.Errorf("event progress did not match, got %+#v", progress)
+	}
+	if len(progress.Requirements) != 2 {
+		t.Fatalf("expected 2 requirements, got %+#v", progress.Requirements)
+	}
+	if progress.Requirements[0].Remaining() != 7 || progress.Requirements[1].Remaining() != 0 {
+		t.Errorf("unexpected requirements %+#v", progress.Requirements)
+	}
+}
+
+func TestEventProgressForClientEscapesEventId(t *testing.T) {
+	// Setup test
+	uriMapping := map[string]string{
+		"https://p.eagate.573.jp/game/ddr/ddra20/p/event/extra_savior%2F..%2Fplaydata/index.html": "./test_data/event/extra_savior.html",
+	}
+
+	c, s := testServerAndClient(uriMapping)
+	defer s.Close()
+
+	// Run Test
+	progress, err := eventProgressForClient(c, "extra_savior/../playdata", 12345678)
+	if err != nil {
+		t.Fatalf("error in eventProgressForClient: %s", err.Error())
+	}
+	if progress.Name != "EXTRA SAVIOR" {
+		t.Errorf("event progress did not match, got %+#v", progress)
+	}
+}
@@ -0,0 +1,79 @@
+package ddr
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/chris-sg/eagate/util"
+	"github.com/golang/glog"
+)
+
+// jacketKeyPrefix is the BlobStore key prefix of DDR jackets.
+const jacketKeyPrefix = "ddr/jackets/"
+
+// JacketImage is a song jacket along with its detected format.
+type JacketImage = util.JacketImage
+
+// JacketCache is a util.JacketCache for DDR jackets, which can also look
+// jackets up by song id.
+type JacketCache struct {
+	*util.JacketCache
+
+	client util.EaClient
+}
+
+func NewJacketCache(client util.EaClient, store util.BlobStore) *JacketCache {
+	return &JacketCache{util.NewJacketCache(client, store, jacketKeyPrefix), client}
+}
+
+// jacketPath returns the path of the full size jacket for a song.
+func jacketPath(songId string) string {
+	const jacketResource = "/game/ddr/ddra20/p/images/binary_jk.html?img={id}&kind=1"
+	return strings.Replace(jacketResource, "{id}", songId, -1)
+}
+
+// JacketForSong returns the full size jacket for a song.
+func (cache *JacketCache) JacketForSong(songId string) (JacketImage, error) {
+	return cache.Jacket(util.BuildEaURI(jacketPath(songId)))
+}
+
+// JacketsForClient will load the jackets for all songIds through the
+// cache, keyed by song id.
+func JacketsForClient(cache *JacketCache, songIds []string) (jackets map[string]JacketImage, err error) {
+	mtx := &sync.Mutex{}
+	jackets = make(map[string]JacketImage)
+
+	wg := new(sync.WaitGroup)
+	wg.Add(len(songIds))
+
+	errCount := 0
+
+	for _, id := range songIds {
+		go func(songId string) {
+			defer wg.Done()
+			jacket, err := cache.JacketForSong(songId)
+
+			mtx.Lock()
+			defer mtx.Unlock()
+			if err != nil {
+				glog.Errorf("failed to load jacket for song id %s: %s\n", songId, err.Error())
+				errCount++
+				return
+			}
+			jackets[songId] = jacket
+		}(id)
+	}
+
+	wg.Wait()
+	glog.Infof("loaded %d jackets for user %s\n", len(jackets), cache.client.GetUsername())
+
+	if errCount > 0 {
+		err = fmt.Errorf("failed to load jackets for %d/%d songs", errCount, len(songIds))
+	}
+	return
+}
+
+func jacketBlobKey(hash string) string {
+	return util.JacketBlobKey(jacketKeyPrefix, hash)
+}
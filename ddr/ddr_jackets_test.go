@@ -0,0 +1,47 @@
+package ddr
+
+import (
+	"github.com/chris-sg/eagate/util"
+	"testing"
+)
+
+func TestJacketCache(t *testing.T) {
+	// Setup test
+	const songId = "1PoOQPd0D01Q9O0doiQQQ8D8Q096bDq9"
+	const jacketUri = "https://p.eagate.573.jp/game/ddr/ddra20/p/images/binary_jk.html?img=1PoOQPd0D01Q9O0doiQQQ8D8Q096bDq9&kind=1"
+	uriMapping := map[string]string{
+		jacketUri: "./test_data/jacket/1PoOQPd0D01Q9O0doiQQQ8D8Q096bDq9.jpg",
+	}
+
+	c, s := testServerAndClient(uriMapping)
+	defer s.Close()
+	store := util.NewMemoryBlobStore()
+
+	// Run Test
+	jacket, err := NewJacketCache(c, store).JacketForSong(songId)
+	if err != nil {
+		t.Fatalf("failed to load jacket: %s", err.Error())
+	}
+	if jacket.ContentType != "image/jpeg" || jacket.Width == 0 || jacket.Height == 0 || len(jacket.Data) != 10552 {
+		t.Errorf("unexpected jacket %s %dx%d with %d bytes", jacket.ContentType, jacket.Width, jacket.Height, len(jacket.Data))
+	}
+	if exists, _ := store.Exists(jacketBlobKey(jacket.Hash)); !exists {
+		t.Errorf("expected jacket to be stored by hash")
+	}
+
+	// A new cache over the same store should not need the client.
+	offline, s2 := testServerAndClient(map[string]string{})
+	defer s2.Close()
+	cached, err := NewJacketCache(offline, store).JacketForSong(songId)
+	if err != nil {
+		t.Fatalf("failed to load cached jacket: %s", err.Error())
+	}
+	if cached.Hash != jacket.Hash || cached.Width != jacket.Width {
+		t.Errorf("cached jacket did not match, expected %s got %s", jacket.Hash, cached.Hash)
+	}
+
+	jackets, err := JacketsForClient(NewJacketCache(offline, util.NewMemoryBlobStore()), []string{songId})
+	if err == nil || len(jackets) != 0 {
+		t.Errorf("expected an error loading jackets without a store or server")
+	}
+}
@@ -0,0 +1,122 @@
+package ddr
+
+import (
+	"fmt"
+	"github.com/PuerkitoBio/goquery"
+	"github.com/chris-sg/eagate/util"
+	"github.com/chris-sg/eagate_models/ddr_models"
+	"github.com/golang/glog"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// SongDetails extends ddr_models.Song with the version the song was added
+// in, which the shared model does not store.
+type SongDetails struct {
+	ddr_models.Song
+
+	Version string
+}
+
+// publicMusicListForClient will load every song on the public music list.
+// The page does not require a login, so a client generated without an
+// e-amusement cookie can be used. Songs carry their version but no jacket
+// image. The page URL and layout have not been checked against a captured
+// page, so this stays unexported until they are.
+func publicMusicListForClient(client util.EaClient) (songs []SongDetails, difficulties []ddr_models.SongDifficulty, err error) {
+	document, err := publicMusicListDocument(client, 0)
+	if err != nil {
+		return
+	}
+	songs, difficulties = songsFromPublicMusicListDocument(document)
+	pageCount := pageCountFromMusicDataDocument(document)
+
+	mtx := &sync.Mutex{}
+
+	wg := new(sync.WaitGroup)
+	errCount := 0
+
+	for idx := 1; idx < pageCount; idx++ {
+		wg.Add(1)
+		go func(page int) {
+			defer wg.Done()
+
+			document, err := publicMusicListDocument(client, page)
+			if err != nil {
+				glog.Errorf("failed to load public music list page %d: %s\n", page, err.Error())
+				mtx.Lock()
+				errCount++
+				mtx.Unlock()
+				return
+			}
+			pageSongs, pageDifficulties := songsFromPublicMusicListDocument(document)
+
+			mtx.Lock()
+			defer mtx.Unlock()
+			songs = append(songs, pageSongs...)
+			difficulties = append(difficulties, pageDifficulties...)
+		}(idx)
+	}
+
+	wg.Wait()
+	glog.Infof("loaded %d songs with %d difficulties from the public music list\n", len(songs), len(difficulties))
+
+	if errCount > 0 {
+		err = fmt.Errorf("failed to load %d/%d music list pages", errCount, pageCount)
+	}
+	return
+}
+
+func songsFromPublicMusicListDocument(document *goquery.Document) (songs []SongDetails, difficulties []ddr_models.SongDifficulty) {
+	document.Find("table#data_tbl tr.data").Each(func(i int, s *goquery.Selection) {
+		jacket, exists := s.Find("img").First().Attr("src")
+		if !exists {
+			return
+		}
+		songId := songIdFromJacketPath(jacket)
+		if songId == "" {
+			return
+		}
+
+		song := SongDetails{}
+		song.Id = songId
+		song.Name = strings.TrimSpace(s.Find("div.music_tit").First().Text())
+		song.Artist = strings.TrimSpace(s.Find("div.artist_nam").First().Text())
+		song.Version = strings.TrimSpace(s.Find("td.version").First().Text())
+		songs = append(songs, song)
+
+		// Levels are listed as single beginner to challenge followed by
+		// double basic to challenge.
+		s.Find("td.difficulty").Each(func(i int, levelSelection *goquery.Selection) {
+			level, err := strconv.ParseInt(strings.TrimSpace(levelSelection.Text()), 10, 16)
+			if err != nil {
+				return
+			}
+			mode := ddr_models.Single
+			difficulty := ddr_models.Difficulty(i)
+			if i >= 5 {
+				mode = ddr_models.Double
+				difficulty = ddr_models.Difficulty(i - 4)
+			}
+			difficulties = append(difficulties, ddr_models.SongDifficulty{
+				SongId:          songId,
+				Mode:            mode.String(),
+				Difficulty:      difficulty.String(),
+				DifficultyValue: int16(level),
+			})
+		})
+	})
+	return
+}
+
+// songIdFromJacketPath returns the song id from a jacket image path such
+// as "/game/ddr/ddra20/p/images/binary_jk.html?img={id}&kind=2".
+func songIdFromJacketPath(jacketPath string) string {
+	jacketUrl, err := url.Parse(jacketPath)
+	if err != nil {
+		return ""
+	}
+	return jacketUrl.Query().Get("img")
+}
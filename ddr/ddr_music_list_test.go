@@ -0,0 +1,58 @@
+package ddr
+
+import (
+	"testing"
+)
+
+func TestSongsFromPublicMusicListDocument(t *testing.T) {
+	// Setup test
+	const testFile = "./test_data/music_list/music_list_0.html"
+
+	// Run Test
+	document, err := documentFromFile(testFile)
+	if err != nil {
+		t.Fatalf("could not load %s: %s", testFile, err.Error())
+	}
+
+	songs, difficulties := songsFromPublicMusicListDocument(document)
+	if len(songs) != 2 {
+		t.Fatalf("expected 2 songs, got %d", len(songs))
+	}
+	if songs[0].Id != "1PoOQPd0D01Q9O0doiQQQ8D8Q096bDq9" ||
+		songs[0].Name != "printemps" ||
+		songs[0].Artist != "Qrispy Joybox" ||
+		songs[0].Version != "DanceDanceRevolution A" {
+		t.Errorf("song did not match, got %+#v", songs[0])
+	}
+
+	if len(difficulties) != 16 {
+		t.Fatalf("expected 16 difficulties, got %d", len(difficulties))
+	}
+	last := difficulties[len(difficulties)-1]
+	if last.SongId != "8bQQ0lP96186D8Ibo8IoOd6o16qioiIo" ||
+		last.Mode != "DOUBLE" ||
+		last.Difficulty != "CHALLENGE" ||
+		last.DifficultyValue != 17 {
+		t.Errorf("difficulty did not match, got %+#v", last)
+	}
+}
+
+func TestPublicMusicListForClient(t *testing.T) {
+	// Setup test
+	const musicListUri = "https://p.eagate.573.jp/game/ddr/ddra20/p/music/index.html?offset=0&filter=0&filtertype=0&sorttype=0"
+	uriMapping := map[string]string{
+		musicListUri: "./test_data/music_list/music_list_0.html",
+	}
+
+	c, s := testServerAndClient(uriMapping)
+	defer s.Close()
+
+	// Run Test
+	songs, difficulties, err := publicMusicListForClient(c)
+	if err != nil {
+		t.Fatalf("error loading public music list: %s", err.Error())
+	}
+	if len(songs) != 2 || len(difficulties) != 16 {
+		t.Errorf("expected 2 songs and 16 difficulties, got %d and %d", len(songs), len(difficulties))
+	}
+}
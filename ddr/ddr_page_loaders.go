@@ -4,6 +4,7 @@ import (
 	"github.com/PuerkitoBio/goquery"
 	"github.com/chris-sg/eagate/util"
 	"github.com/chris-sg/eagate_models/ddr_models"
+	"net/url"
 	"strconv"
 	"strings"
 )
@@ -63,4 +64,30 @@ func workoutDocument(client util.EaClient) (document *goquery.Document, err erro
 
 	document, err = util.GetPageContentAsGoQuery(client.Client, workoutUri)
 	return
-}
\ No newline at end of file
+}
+
+func goldenLeagueDocument(client util.EaClient) (document *goquery.Document, err error) {
+	const goldenLeagueResource = "/game/ddr/ddra20/p/playdata/golden_league.html"
+	goldenLeagueUri := util.BuildEaURI(goldenLeagueResource)
+
+	document, err = util.GetPageContentAsGoQuery(client.Client, goldenLeagueUri)
+	return
+}
+
+func eventDocument(client util.EaClient, eventId string) (document *goquery.Document, err error) {
+	const eventResource = "/game/ddr/ddra20/p/event/{event}/index.html"
+	eventUri := util.BuildEaURI(eventResource)
+
+	eventUri = strings.Replace(eventUri, "{event}", url.PathEscape(eventId), -1)
+	document, err = util.GetPageContentAsGoQuery(client.Client, eventUri)
+	return
+}
+
+func publicMusicListDocument(client util.EaClient, pageNumber int) (document *goquery.Document, err error) {
+	const musicListResource = "/game/ddr/ddra20/p/music/index.html?offset={page}&filter=0&filtertype=0&sorttype=0"
+	musicListUri := util.BuildEaURI(musicListResource)
+
+	musicListUri = strings.Replace(musicListUri, "{page}", strconv.Itoa(pageNumber), -1)
+	document, err = util.GetPageContentAsGoQuery(client.Client, musicListUri)
+	return
+}
@@ -0,0 +1,136 @@
+package ddr
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/chris-sg/eagate/util"
+	"github.com/chris-sg/eagate_models/ddr_models"
+	"github.com/golang/glog"
+	"io"
+	"sort"
+	"time"
+)
+
+// recentScoresLimit is the number of plays shown on the music_recent page.
+const recentScoresLimit = 50
+
+// PlayHistory is a persistent log of plays built up from successive
+// recent score fetches, which only ever show the last 50 plays.
+type PlayHistory struct {
+	PlayerCode    int                `json:"code"`
+	Scores        []ddr_models.Score `json:"scores"`
+	LastSync      time.Time          `json:"lastsync"`
+	LastPlaycount int                `json:"lastplaycount"`
+
+	keys map[string]bool
+}
+
+// PlayHistoryMergeResult describes the outcome of merging one fetch of
+// recent scores into a PlayHistory.
+type PlayHistoryMergeResult struct {
+	Added      int
+	Duplicates int
+	// GapDetected is set when the fetch could not be joined to the
+	// existing log, meaning plays were lost between syncs.
+	GapDetected bool
+	// EstimatedMissed is the number of plays that were probably lost,
+	// derived from the change in total playcount. It is only filled when
+	// the playcount is known for both syncs.
+	EstimatedMissed int
+}
+
+// ScoreKey returns the key used to dedupe a play across fetches.
+func ScoreKey(score ddr_models.Score) string {
+	return fmt.Sprintf("%s|%s|%s|%d|%d", score.SongId, score.Mode, score.Difficulty, score.TimePlayed.Unix(), score.Score)
+}
+
+// NewPlayHistory will create an empty history for the given player.
+func NewPlayHistory(playerCode int) *PlayHistory {
+	return &PlayHistory{PlayerCode: playerCode}
+}
+
+// ReadPlayHistory will load a history previously written with Write.
+func ReadPlayHistory(r io.Reader) (history *PlayHistory, err error) {
+	history = &PlayHistory{}
+	err = json.NewDecoder(r).Decode(history)
+	return
+}
+
+// Write will persist the history as JSON.
+func (history *PlayHistory) Write(w io.Writer) error {
+	return json.NewEncoder(w).Encode(history)
+}
+
+// Merge will add the plays from a recent scores fetch to the history,
+// skipping any that are already logged. playcount should be the total
+// playcount at the time of the fetch, or 0 if it is not known.
+func (history *PlayHistory) Merge(scores []ddr_models.Score, playcount int, syncTime time.Time) (result PlayHistoryMergeResult) {
+	if history.keys == nil {
+		history.keys = make(map[string]bool)
+		for _, score := range history.Scores {
+			history.keys[ScoreKey(score)] = true
+		}
+	}
+	hadHistory := len(history.Scores) > 0
+
+	for _, score := range scores {
+		key := ScoreKey(score)
+		if history.keys[key] {
+			result.Duplicates++
+			continue
+		}
+		history.keys[key] = true
+		history.Scores = append(history.Scores, score)
+		result.Added++
+	}
+
+	sort.SliceStable(history.Scores, func(i, j int) bool {
+		return history.Scores[i].TimePlayed.After(history.Scores[j].TimePlayed)
+	})
+
+	if hadHistory && playcount > 0 && history.LastPlaycount > 0 {
+		missed := playcount - history.LastPlaycount - result.Added
+		if missed > 0 {
+			result.GapDetected = true
+			result.EstimatedMissed = missed
+		}
+	} else if hadHistory && result.Duplicates == 0 && len(scores) >= recentScoresLimit {
+		result.GapDetected = true
+	}
+
+	if result.GapDetected {
+		glog.Warningf("play history for %d has a gap, estimated %d plays missed\n", history.PlayerCode, result.EstimatedMissed)
+	}
+
+	if playcount > 0 {
+		history.LastPlaycount = playcount
+	}
+	history.LastSync = syncTime
+	return
+}
+
+// SyncPlayHistoryForClient will fetch the recent scores and playcount
+// for a client and merge them into the history. Only the total playcount
+// is required; the single and double rows are not shown for players who
+// have never played that style.
+func SyncPlayHistoryForClient(client util.EaClient, history *PlayHistory) (result PlayHistoryMergeResult, err error) {
+	document, err := playerInformationDocument(client)
+	if err != nil {
+		return
+	}
+	playcount, missing, err := playcountFieldsFromPlayerDocument(document)
+	if err != nil {
+		return
+	}
+	if _, found := util.Find(missing, "Playcount"); found {
+		err = fmt.Errorf("cannot parse playcount")
+		return
+	}
+	scores, err := RecentScoresForClient(client, history.PlayerCode)
+	if err != nil {
+		return
+	}
+	result = history.Merge(scores, playcount.Playcount, time.Now())
+	glog.Infof("merged %d new plays into history for user %s\n", result.Added, client.GetUsername())
+	return
+}
@@ -0,0 +1,104 @@
+package ddr
+
+import (
+	"bytes"
+	"github.com/chris-sg/eagate_models/ddr_models"
+	"testing"
+	"time"
+)
+
+func testScores(start time.Time, count int) (scores []ddr_models.Score) {
+	for i := 0; i < count; i++ {
+		played := start.Add(time.Duration(-i) * time.Minute)
+		scores = append(scores, ddr_models.Score{
+			Score:      900000 + played.Minute(),
+			TimePlayed: played,
+			SongId:     "song",
+			Mode:       "SINGLE",
+			Difficulty: "EXPERT",
+			PlayerCode: 12345678,
+		})
+	}
+	return
+}
+
+func TestPlayHistoryMerge(t *testing.T) {
+	// Setup test
+	start := time.Date(2020, 3, 18, 18, 0, 0, 0, time.UTC)
+	history := NewPlayHistory(12345678)
+
+	// Run Test
+	result := history.Merge(testScores(start, 50), 100, start)
+	if result.Added != 50 || result.GapDetected {
+		t.Errorf("unexpected result for first merge: %+#v", result)
+	}
+
+	overlapping := testScores(start.Add(10*time.Minute), 50)
+	result = history.Merge(overlapping, 110, start.Add(10*time.Minute))
+	if result.Added != 10 || result.Duplicates != 40 || result.GapDetected {
+		t.Errorf("unexpected result for overlapping merge: %+#v", result)
+	}
+
+	later := testScores(start.Add(200*time.Minute), 50)
+	result = history.Merge(later, 240, start.Add(200*time.Minute))
+	if result.Added != 50 || !result.GapDetected || result.EstimatedMissed != 80 {
+		t.Errorf("unexpected result for gap merge: %+#v", result)
+	}
+
+	if len(history.Scores) != 110 {
+		t.Errorf("expected 110 logged plays, got %d", len(history.Scores))
+	}
+	if !history.Scores[0].TimePlayed.Equal(start.Add(200 * time.Minute)) {
+		t.Errorf("expected newest play first, got %s", history.Scores[0].TimePlayed)
+	}
+}
+
+func TestPlayHistoryPersistence(t *testing.T) {
+	// Setup test
+	start := time.Date(2020, 3, 18, 18, 0, 0, 0, time.UTC)
+	history := NewPlayHistory(12345678)
+	history.Merge(testScores(start, 5), 100, start)
+
+	// Run Test
+	var b bytes.Buffer
+	if err := history.Write(&b); err != nil {
+		t.Fatalf("failed to write history: %s", err.Error())
+	}
+	loaded, err := ReadPlayHistory(&b)
+	if err != nil {
+		t.Fatalf("failed to read history: %s", err.Error())
+	}
+
+	result := loaded.Merge(testScores(start, 5), 0, start)
+	if result.Added != 0 || result.Duplicates != 5 {
+		t.Errorf("expected all plays to dedupe after reload, got %+#v", result)
+	}
+	if loaded.LastPlaycount != 100 {
+		t.Errorf("expected last playcount of 100, got %d", loaded.LastPlaycount)
+	}
+}
+
+func TestSyncPlayHistoryForClientWithoutDoublePlays(t *testing.T) {
+	// Setup test
+	uriMapping := map[string]string{
+		"https://p.eagate.573.jp/game/ddr/ddra20/p/playdata/index.html":        "./test_data/player/index_no_double.html",
+		"https://p.eagate.573.jp/game/ddr/ddra20/p/playdata/music_recent.html": "./test_data/player/recent_scores.html",
+	}
+
+	c, s := testServerAndClient(uriMapping)
+	defer s.Close()
+
+	history := NewPlayHistory(12345678)
+
+	// Run Test
+	result, err := SyncPlayHistoryForClient(c, history)
+	if err != nil {
+		t.Fatalf("error in SyncPlayHistoryForClient: %s", err.Error())
+	}
+	if result.Added == 0 || len(history.Scores) != result.Added {
+		t.Errorf("expected recent scores to be merged, got %+#v", result)
+	}
+	if history.LastPlaycount == 0 {
+		t.Errorf("expected the total playcount to be recorded")
+	}
+}
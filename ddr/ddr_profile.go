@@ -0,0 +1,102 @@
+package ddr
+
+import (
+	"fmt"
+	"github.com/PuerkitoBio/goquery"
+	"github.com/chris-sg/eagate/util"
+	"github.com/chris-sg/eagate_models/ddr_models"
+	"strconv"
+)
+
+// PlayerProfile extends ddr_models.PlayerDetails with the fields that
+// could not be read from the player status page.
+type PlayerProfile struct {
+	ddr_models.PlayerDetails
+
+	// MissingFields lists, by their Go field name, the PlayerDetails and
+	// Playcount fields that were not shown or could not be parsed, leaving
+	// their zero value.
+	MissingFields []string
+}
+
+// PlayerProfileForClient will load the full player profile for a client
+// along with the playcount. Missing profile and playcount rows are
+// recorded in the profile's MissingFields rather than failing the load;
+// an error is only returned if the page or its status table could not be
+// loaded.
+func PlayerProfileForClient(client util.EaClient) (profile PlayerProfile, playcount ddr_models.Playcount, err error) {
+	document, err := playerInformationDocument(client)
+	if err != nil {
+		return
+	}
+	profile, err = playerProfileFromDocument(document)
+	if err != nil {
+		return
+	}
+	playcount, missing, err := playcountFieldsFromPlayerDocument(document)
+	if err != nil {
+		return
+	}
+	for _, field := range missing {
+		if _, found := util.Find(profile.MissingFields, field); !found {
+			profile.MissingFields = append(profile.MissingFields, field)
+		}
+	}
+	eaGateUser := client.GetUsername()
+	profile.EaGateUser = &eaGateUser
+	return
+}
+
+// playerProfileFromDocument will parse every profile field it can find.
+// An error is only returned if the status table itself is missing.
+func playerProfileFromDocument(document *goquery.Document) (profile PlayerProfile, err error) {
+	status := document.Find("table#status").First()
+	if status.Length() == 0 {
+		err = fmt.Errorf("cannot find status table")
+		return
+	}
+	details, err := util.TableThTd(status)
+	if err != nil {
+		return
+	}
+	document.Find("div.main table").Each(func(i int, s *goquery.Selection) {
+		tableDetails, err := util.TableThTd(s)
+		if err != nil {
+			return
+		}
+		for k, v := range tableDetails {
+			if _, exists := details[k]; !exists {
+				details[k] = v
+			}
+		}
+	})
+
+	stringFields := []struct {
+		tag   string
+		name  string
+		value *string
+	}{
+		{"ダンサーネーム", "Name", &profile.Name},
+		{"所属都道府県", "Prefecture", &profile.Prefecture},
+		{"段位(SINGLE)", "SingleRank", &profile.SingleRank},
+		{"段位(DOUBLE)", "DoubleRank", &profile.DoubleRank},
+		{"所属クラス", "Affiliation", &profile.Affiliation},
+	}
+	for _, field := range stringFields {
+		value, ok := details[field.tag]
+		if !ok {
+			profile.MissingFields = append(profile.MissingFields, field.name)
+			continue
+		}
+		*field.value = value
+	}
+
+	code, parseErr := strconv.ParseInt(details["DDR-CODE"], 10, 32)
+	if parseErr != nil {
+		profile.MissingFields = append(profile.MissingFields, "Code")
+	} else {
+		profile.Code = int(code)
+	}
+
+	return
+}
@@ -0,0 +1,64 @@
+package ddr
+
+import (
+	"testing"
+)
+
+func TestPlayerProfileFromDocument(t *testing.T) {
+	// Setup test
+	const testFile = "./test_data/player/index.html"
+
+	// Run Test
+	document, err := documentFromFile(testFile)
+	if err != nil {
+		t.Fatalf("could not load %s: %s", testFile, err.Error())
+	}
+
+	profile, err := playerProfileFromDocument(document)
+	if err != nil {
+		t.Fatalf("error in playerProfileFromDocument: %s", err.Error())
+	}
+
+	if profile.Code != 12345678 ||
+		profile.Name != "EAGATE" ||
+		profile.Affiliation != "所属なし" {
+		t.Errorf("player profile did not match, got %+#v", profile)
+	}
+
+	if len(profile.MissingFields) != 0 {
+		t.Errorf("expected no missing fields, got %v", profile.MissingFields)
+	}
+}
+
+func TestPlayerProfileForClientRecordsMissingPlaycount(t *testing.T) {
+	// Setup test
+	uriMapping := map[string]string{
+		"https://p.eagate.573.jp/game/ddr/ddra20/p/playdata/index.html": "./test_data/player/index_no_double.html",
+	}
+
+	c, s := testServerAndClient(uriMapping)
+	defer s.Close()
+
+	// Setup expected results
+	const expectedSinglePlaycount = 360
+
+	// Run Test
+	profile, playcount, err := PlayerProfileForClient(c)
+	if err != nil {
+		t.Fatalf("error in PlayerProfileForClient: %s", err.Error())
+	}
+	if playcount.SinglePlaycount != expectedSinglePlaycount {
+		t.Errorf("expected a single playcount of %d, got %d", expectedSinglePlaycount, playcount.SinglePlaycount)
+	}
+	for _, field := range []string{"DoublePlaycount", "DoubleLastPlayDate"} {
+		found := false
+		for _, missing := range profile.MissingFields {
+			if missing == field {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("expected %s to be reported missing, got %v", field, profile.MissingFields)
+		}
+	}
+}
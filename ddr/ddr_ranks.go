@@ -0,0 +1,271 @@
+package ddr
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+)
+
+// DanceLevel is the rank awarded for a score, ordered from lowest to
+// highest so levels can be compared directly.
+type DanceLevel int
+
+const (
+	NoDanceLevel DanceLevel = iota
+	DanceLevelE
+	DanceLevelD
+	DanceLevelDPlus
+	DanceLevelCMinus
+	DanceLevelC
+	DanceLevelCPlus
+	DanceLevelBMinus
+	DanceLevelB
+	DanceLevelBPlus
+	DanceLevelAMinus
+	DanceLevelA
+	DanceLevelAPlus
+	DanceLevelAAMinus
+	DanceLevelAA
+	DanceLevelAAPlus
+	DanceLevelAAA
+)
+
+var danceLevelLabels = [...]string{
+	"---",
+	"E",
+	"D",
+	"D+",
+	"C-",
+	"C",
+	"C+",
+	"B-",
+	"B",
+	"B+",
+	"A-",
+	"A",
+	"A+",
+	"AA-",
+	"AA",
+	"AA+",
+	"AAA",
+}
+
+func (level DanceLevel) String() string {
+	if level < 0 || int(level) >= len(danceLevelLabels) {
+		return danceLevelLabels[NoDanceLevel]
+	}
+	return danceLevelLabels[level]
+}
+
+// StringToDanceLevel will convert the text shown on the site, such as
+// "AA+", into a DanceLevel. Unknown text results in NoDanceLevel.
+func StringToDanceLevel(level string) DanceLevel {
+	level = strings.ToUpper(strings.TrimSpace(level))
+	for i, label := range danceLevelLabels {
+		if label == level {
+			return DanceLevel(i)
+		}
+	}
+	return NoDanceLevel
+}
+
+// DanceLevelFromImage will convert a rank image path, such as
+// ".../rank_s_aa_p.png", into a DanceLevel.
+func DanceLevelFromImage(src string) DanceLevel {
+	name := imageName(src)
+	if !strings.HasPrefix(name, "rank_s_") {
+		return NoDanceLevel
+	}
+	level := strings.TrimPrefix(name, "rank_s_")
+	if strings.HasSuffix(level, "_p") {
+		level = strings.TrimSuffix(level, "_p") + "+"
+	} else if strings.HasSuffix(level, "_m") {
+		level = strings.TrimSuffix(level, "_m") + "-"
+	}
+	return StringToDanceLevel(level)
+}
+
+func (level DanceLevel) MarshalJSON() ([]byte, error) {
+	return json.Marshal(level.String())
+}
+
+func (level *DanceLevel) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	*level = StringToDanceLevel(s)
+	if *level == NoDanceLevel && s != NoDanceLevel.String() && s != "" {
+		return fmt.Errorf("unknown dance level %s", s)
+	}
+	return nil
+}
+
+// FullComboType is the full combo lamp for a chart, ordered from lowest
+// to highest.
+type FullComboType int
+
+const (
+	NoFullCombo FullComboType = iota
+	FullComboLife4
+	FullComboGood
+	FullComboGreat
+	FullComboPerfect
+	FullComboMarvelous
+)
+
+var fullComboTypeLabels = [...]string{
+	"---",
+	"LIFE4",
+	"FC",
+	"GFC",
+	"PFC",
+	"MFC",
+}
+
+func (fc FullComboType) String() string {
+	if fc < 0 || int(fc) >= len(fullComboTypeLabels) {
+		return fullComboTypeLabels[NoFullCombo]
+	}
+	return fullComboTypeLabels[fc]
+}
+
+// StringToFullComboType will convert either the short label, such as
+// "PFC", or the text shown on the site, such as "パーフェクトフルコンボ",
+// into a FullComboType.
+func StringToFullComboType(fc string) FullComboType {
+	fc = strings.ToUpper(strings.TrimSpace(fc))
+	for i, label := range fullComboTypeLabels {
+		if label == fc {
+			return FullComboType(i)
+		}
+	}
+	siteLabels := map[string]FullComboType{
+		"マーベラスフルコンボ":  FullComboMarvelous,
+		"パーフェクトフルコンボ": FullComboPerfect,
+		"グレートフルコンボ":   FullComboGreat,
+		"グッドフルコンボ":    FullComboGood,
+		"フルコンボ":       FullComboGood,
+		"LIFE4フルコンボ":  FullComboLife4,
+	}
+	return siteLabels[fc]
+}
+
+// FullComboTypeFromImage will convert a full combo image path, such as
+// ".../full_perfect.png", into a FullComboType.
+func FullComboTypeFromImage(src string) FullComboType {
+	imageLabels := map[string]FullComboType{
+		"full_mar":     FullComboMarvelous,
+		"full_perfect": FullComboPerfect,
+		"full_great":   FullComboGreat,
+		"full_good":    FullComboGood,
+		"full_life4":   FullComboLife4,
+	}
+	return imageLabels[imageName(src)]
+}
+
+func (fc FullComboType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(fc.String())
+}
+
+func (fc *FullComboType) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	*fc = StringToFullComboType(s)
+	if *fc == NoFullCombo && s != NoFullCombo.String() && s != "" {
+		return fmt.Errorf("unknown full combo type %s", s)
+	}
+	return nil
+}
+
+// ClearType is the way a chart was cleared, ordered from lowest to
+// highest.
+type ClearType int
+
+const (
+	NoClear ClearType = iota
+	ClearFailed
+	ClearAssist
+	ClearNormal
+	ClearLife4
+	ClearFlare
+)
+
+var clearTypeLabels = [...]string{
+	"NO PLAY",
+	"FAILED",
+	"ASSIST",
+	"CLEAR",
+	"LIFE4",
+	"FLARE",
+}
+
+func (clear ClearType) String() string {
+	if clear < 0 || int(clear) >= len(clearTypeLabels) {
+		return clearTypeLabels[NoClear]
+	}
+	return clearTypeLabels[clear]
+}
+
+func StringToClearType(clear string) ClearType {
+	clear = strings.ToUpper(strings.TrimSpace(clear))
+	for i, label := range clearTypeLabels {
+		if label == clear {
+			return ClearType(i)
+		}
+	}
+	return NoClear
+}
+
+// ClearTypeFromImage will determine the clear type from either a clear
+// image, such as ".../clear_assist.png", or a rank image, where an E
+// rank means the play was failed.
+func ClearTypeFromImage(src string) ClearType {
+	imageLabels := map[string]ClearType{
+		"clear_failed": ClearFailed,
+		"clear_assist": ClearAssist,
+		"clear_normal": ClearNormal,
+		"clear_life4":  ClearLife4,
+		"clear_flare":  ClearFlare,
+	}
+	if clear, ok := imageLabels[imageName(src)]; ok {
+		return clear
+	}
+	switch DanceLevelFromImage(src) {
+	case NoDanceLevel:
+		return NoClear
+	case DanceLevelE:
+		return ClearFailed
+	default:
+		return ClearNormal
+	}
+}
+
+func (clear ClearType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(clear.String())
+}
+
+func (clear *ClearType) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	*clear = StringToClearType(s)
+	if *clear == NoClear && s != NoClear.String() && s != "" {
+		return fmt.Errorf("unknown clear type %s", s)
+	}
+	return nil
+}
+
+// imageName returns the file name of an image path without its
+// extension or query.
+func imageName(src string) string {
+	if i := strings.Index(src, "?"); i >= 0 {
+		src = src[:i]
+	}
+	name := path.Base(src)
+	return strings.TrimSuffix(name, path.Ext(name))
+}
@@ -0,0 +1,80 @@
+package ddr
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDanceLevelFromImage(t *testing.T) {
+	cases := map[string]DanceLevel{
+		"/game/ddr/ddra20/p/images/play_data/rank_s_e.png":    DanceLevelE,
+		"/game/ddr/ddra20/p/images/play_data/rank_s_aa_p.png": DanceLevelAAPlus,
+		"/game/ddr/ddra20/p/images/play_data/rank_s_a_m.png":  DanceLevelAMinus,
+		"/game/ddr/ddra20/p/images/play_data/rank_s_aaa.png":  DanceLevelAAA,
+		"/game/ddr/ddra20/p/images/play_data/unknown.png":     NoDanceLevel,
+	}
+	for src, expected := range cases {
+		if level := DanceLevelFromImage(src); level != expected {
+			t.Errorf("expected %s for %s, got %s", expected, src, level)
+		}
+	}
+}
+
+func TestStringToFullComboType(t *testing.T) {
+	cases := map[string]FullComboType{
+		"マーベラスフルコンボ":  FullComboMarvelous,
+		"パーフェクトフルコンボ": FullComboPerfect,
+		"グレートフルコンボ":   FullComboGreat,
+		"グッドフルコンボ":    FullComboGood,
+		"PFC":         FullComboPerfect,
+		"---":         NoFullCombo,
+	}
+	for text, expected := range cases {
+		if fc := StringToFullComboType(text); fc != expected {
+			t.Errorf("expected %s for %s, got %s", expected, text, fc)
+		}
+	}
+}
+
+func TestClearTypeFromImage(t *testing.T) {
+	cases := map[string]ClearType{
+		"/game/ddr/ddra20/p/images/play_data/rank_s_e.png":     ClearFailed,
+		"/game/ddr/ddra20/p/images/play_data/rank_s_aa_p.png":  ClearNormal,
+		"/game/ddr/ddra20/p/images/play_data/clear_life4.png":  ClearLife4,
+		"/game/ddr/ddra20/p/images/play_data/clear_assist.png": ClearAssist,
+	}
+	for src, expected := range cases {
+		if clear := ClearTypeFromImage(src); clear != expected {
+			t.Errorf("expected %s for %s, got %s", expected, src, clear)
+		}
+	}
+}
+
+func TestRankJSONRoundTrip(t *testing.T) {
+	type ranks struct {
+		Level DanceLevel
+		Lamp  FullComboType
+		Clear ClearType
+	}
+	expected := ranks{DanceLevelAAPlus, FullComboGreat, ClearLife4}
+
+	data, err := json.Marshal(expected)
+	if err != nil {
+		t.Fatalf("failed to marshal ranks: %s", err.Error())
+	}
+	if string(data) != `{"Level":"AA+","Lamp":"GFC","Clear":"LIFE4"}` {
+		t.Errorf("unexpected json %s", string(data))
+	}
+
+	var result ranks
+	if err = json.Unmarshal(data, &result); err != nil {
+		t.Fatalf("failed to unmarshal ranks: %s", err.Error())
+	}
+	if result != expected {
+		t.Errorf("expected %+#v after round trip, got %+#v", expected, result)
+	}
+
+	if err = json.Unmarshal([]byte(`{"Level":"Z"}`), &result); err == nil {
+		t.Errorf("expected an error for an unknown dance level")
+	}
+}
@@ -1,12 +1,9 @@
 package ddr
 
 import (
-	"encoding/base64"
 	"fmt"
 	"github.com/chris-sg/eagate_models/ddr_models"
 	"github.com/golang/glog"
-	"io/ioutil"
-	"net/http"
 	"regexp"
 	"strconv"
 	"strings"
@@ -77,7 +74,11 @@ func pageCountFromMusicDataDocument(document *goquery.Document) (pageCount int)
 	return
 }
 
-func SongDataForClient(client util.EaClient, songIds []string) (songs []ddr_models.Song, err error) {
+// SongDataForClient will load the song data for songIds through the
+// cache's client. Jackets are fetched into the cache's store, and each
+// song's Image holds the jacket hash to pass to JacketForHash.
+func SongDataForClient(cache *JacketCache, songIds []string) (songs []ddr_models.Song, err error) {
+	client := cache.client
 	mtx := &sync.Mutex{}
 
 	wg := new(sync.WaitGroup)
@@ -95,6 +96,14 @@ func SongDataForClient(client util.EaClient, songIds []string) (songs []ddr_mode
 				return
 			}
 			song := songDataFromDocument(document, songId)
+			if imgPath, exists := jacketPathFromDocument(document); exists {
+				jacket, err := cache.Jacket(util.BuildEaURI(imgPath))
+				if err == nil {
+					song.Image = jacket.Hash
+				} else {
+					glog.Warningf("failed to get jacket for song id %s: %s\n", songId, err.Error())
+				}
+			}
 
 			mtx.Lock()
 			defer mtx.Unlock()
@@ -121,25 +130,21 @@ func songDataFromDocument(document *goquery.Document, songId string) (song ddr_m
 			html, _ := s.Html()
 			songDataPair := strings.Split(html, "<br/>")
 			song.Name = songDataPair[0]
-			song.Artist = songDataPair[1]
-		} else {
-			imgPath, exists := img.First().Attr("src")
-			if exists {
-				imgUrl := util.BuildEaURI(imgPath)
-				imgData, err := http.Get(imgUrl)
-				defer imgData.Body.Close()
-				if err == nil {
-					body, err := ioutil.ReadAll(imgData.Body)
-					if err == nil {
-						song.Image = base64.StdEncoding.EncodeToString(body)
-					}
-				}
+			if len(songDataPair) > 1 {
+				song.Artist = songDataPair[1]
 			}
 		}
 	})
 	return
 }
 
+// jacketPathFromDocument returns the jacket image path from a music
+// detail document.
+func jacketPathFromDocument(document *goquery.Document) (imgPath string, exists bool) {
+	imgPath, exists = document.Find("table#music_info").First().Find("td img").First().Attr("src")
+	return
+}
+
 func SongDifficultiesForClient(client util.EaClient, songIds []string) (difficulties []ddr_models.SongDifficulty, err error) {
 	mtx := &sync.Mutex{}
 
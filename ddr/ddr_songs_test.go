@@ -134,7 +134,6 @@ func TestSongDataFromDocument(t *testing.T) {
 		Id:           testId,
 		Name:         "printemps",
 		Artist:       "Qrispy Joybox",
-		Image:        "/9j/4AAQSkZJRgABAQAAAQABAAD/2wBDAAYEBQYFBAYGBQYHBwYIChAKCgkJChQODwwQFxQYGBcUFhYaHSUfGhsjHBYWICwgIyYnKSopGR8tMC0oMCUoKSj/2wBDAQcHBwoIChMKChMoGhYaKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCj/wAARCADIAMgDASIAAhEBAxEB/8QAHAAAAQUBAQEAAAAAAAAAAAAABQADBAYHAgEI/8QAPxAAAgEDAgQDBgIIBQMFAAAAAQIDAAQRBSEGEjFBE1FhFCJxgZGhBzIjQlKxwdHh8BUkM2JygpLxFlOissL/xAAcAQACAwEBAQEAAAAAAAAAAAAEBQIDBgEABwj/xAA2EQABAwIEBAQFAwQCAwAAAAABAAIDBBEFEiExE0FRYQZxgZEUIqGx8CMy0TNCwfFS4WKSwv/aAAwDAQACEQMRAD8A0/FOQQS3D8kCNIx7KCalaNYnUL1YjkIBzOw7Cr9bwxW8SxwxhEG2FprPVCM5RqUqp6XijMdlRJNE1GJOZrViP9uGP0FQCpViCuCNiCK07IA86g6lpttewurxosh6SAbg/GqGVxH7wiH0LSPlVFsLcXN3HCz8gY/mq42Ok6ZEyhYTJJ5yjm/pQHR9Kie7l9vzywty+Gp/Mfj5Vbo7iBFCr7qjyqVTKSbMKhSxAC7wE/HFHGMRoi/8VxXf0+lVTVb64/xEw2njTI5GX51CxgjJ2BB7Y2B6g771HtOMLG3sbua6v4XgsffuJFbn5V94H1Jyp9SRWZOLxtmdFI0ixtfdOWUr3tDmC6s+rWftlo8IWPmYYDOM8vqKCRcJqP8AVumP/BcUTtte0660+G9tLmOa1mUPHIrfmHoOvyqHJxGik8kOVHQs2PtWgh42W0eyU1T6eN36xsQvP/Strjeab03H8qCaros9lN7p8SE7K2wP0z96m3HEsrfl5UH+3+dNy8QaNBh7ya6mfyCf3++io21AOuvZAOqaST5WEDveyECzmY4CkntgUp7G5hTmlgkVfMqQKOLxvoMMYMXig4/KkGD99vvQu+/EZMkWFix8mmbH2H86vY2qedI/fRcc6mYLukHpqh2dvSu0jaQ4UE0Ke7uNVvHu7lVVm68g5QK5mveX9HAuAO+aM+HdtzS410YNgj8VvGHXxpAqk7/CjAXRBHyAnmPRzJk/SqB7YkUgknKvg55WbY/GiMPFmmwjDaNbyeZyf45quSild+258tFdDXxf3WHnf+EWjJlcpEEz1HMQMj50/HZTS4y8S+nOCcfKo+n/AIgabbEKmmeAD1MYGak3/FHCt/GpuoZfFJ/NHHhlPmSMZ+9UuhqGusYzb3RLZadzbiQX9kQi0ezfAaUr6kkn7Cj2jWcFhE0UU/icxzud6yqTiaS2vHFhcSzWgPuC5ALEev8AQ0Y0njC2myt+RbvnZkBKn+VQnoKjLrqPzkrIK+nzWGh/Oa08YoDxWzGxdTbFkGCHxnlPn6UHPHFlbLyibxx2wpJrwcd2EmVkBMbDDKU6jyoSOinY4OyFFyVtO9pbnCFWtpPdMRbRNJjrgdKVDdO1+XTb2aTTyWtnY4ikbO2dtx0OKVMZIagH5ACEujmpyPnJBVs4QIWW5O2cKP31aPEA7ioV6LGygZolhhYd1UDm9PM0PW9MgDo2R6Gs9NITM4kWB29lpKOEcMMvqEe5z2pppux2oRLeM8RCHlcdD5GvIb5VtzNdERFdmB8/TzqL3ABFOhyNLnbKt8S36xa1cLHzFxy55dt+UUK8S8uTgEqD611qEst5xDHLA8QtyWe4LkbL2z/TvUq+HNbSw2syLPJETH72Mjpkfzp5FM2njaxzfmACzpoJqx3EicMhJ+6happ01zoklql5LbTlwyyLIwU7YIYAjIwTt54qBxFwbwbJoot/FuTd8/MLi3kKu6fssGyOwJ26jIxnFCLCK+iiliZZi5cAIc5GM5Pp2+PyqfFpd3LvM6xjyPvGg6mHD2ROdUTCPObnXU+Q1Psrm4niVBJ8LSHM1uxtax7nt0Kl6fPZ6Tp8FhYBltoF5UUnJx5k+dRb3iSKDIG5qVHpECD9IzyH44x8MV6NH09Dn2WJz/vHN+/NKMS8T0zYxDQX6X207c/UhA0mFcSV09f8xJva+5O90Et+KHvZzDbQySP1xGMkCoWoahcXT+HCkjt5ICT9qtgitLJJHCQQIcc7BQgPxO1QZdXtRaPLppiu1R/DbwXBVD646UugxnEqxnw0euawvoPQu0380yFDQ07uO2LbzI9kFsLfU2B8SyuhGMjxGhYrnyLAHHzwKkaDY6jfasY3geKPlz+kUgD1zUiF7/ULkIbh4wT+SLIx860PQLFrKIDneU46Oc0+OHYpRsY41FiNctyR5flx3SmtfTVb2hseXra2v+UF8GO00prq9sriONVJOWwevlQ3TYINbDNZW8oiB3d8hfqDj5VetQjW6Tw50DRn8yNuDQ7VdT0/QdP8e+mjtrdPdUY3PooHU/CpQCpqbmV7m7WLXEEnmbaix7K6mwuNhFwHDu0XQuPhGyIzNzM3+0kY+ea5uOErQrm3wT5P/MVTtX/FaRpWXR7FBGOklySSf+lTt9TUKz/FDV45Qbq2s54+6qpQ/I5P7qZGjkc3Lnd/7uv902GFst/Tb7BWO50eK3cpNbcjDzJ/nUdtOte0f3NWnR9W03i7S2e2ysiYDow9+Jv4j170EuYntp3ilGGU/WsfijsSw592zvLTt8x9jqhX0UANnRj2CAXelz5doCvL2XJyai/4feRrzcq9Mn3htViJriTLIwDFCR+YDcetIZa+qmeJJJXFw2JJ0U42sibkY0AHsqWdbjEnhxrI8mewwKnx3r8m/KTTes6NMA1w+pFlVc/5g438gegqux3cjFliUycoLEqDsB1NPWeIa59iZToLf701Pc6o2HCcPkaSxg77/norRHeOH94gj0FKqxFfucnlPKMZONh5Uquh8QV0Tcokv52P3XZMApJHXy28tFvnFyWVlDhNQllv9vcOG28ye1VW11KezkZ4pinN+bm3B+RoDc6qzSNyAlepZs5NQ5LtmOftWoZimGNYYppAbb6b+Wiz8lPXcQSRMy37/fVW6740ks4SyxLO3ovT161V9V4mvNRkW4cTo6jClBgAHtjoa70p7K7nWOe7ELk4CEEZ+fSrjbWdvagCGJVPmRkn50gxjE6KjkvROuSNg3Y9cx1HoPUL3w2I1Qy1Li0d/wCEB4ekdEuDrFpcRxsF5g8Z5XG+Qdjse+aN2cWgIkr6JFJEZp2lkkMvifmx7g22G3Snbmdba3lnfPJEpdsbnAGTVBu+KLRbEi1S9iuJbkzo8ScniZJ2znvnB60FSYtiNeC4MzHQX2Hr6bp3RyMoIuCXaC51563/ADRaHM3NISM79qbJ+fwoZpf+Ii2tGuirF0BlVxyvG2OmRsfsfU0RdgqlmOANyT2rJzFz5XF25J910P4mtl4dvSuWYAZJwPM9Kr2oa/zMUsyqp/7h6n4CubFku7e7e61ERcighWBPiHfH9+tMRhjoouNUnK3sCTr2Cf0+ATPZxJjlHlc+yNTCzu1EU8drdJnPhyosqk/8TkZp8yh0jCiNY41CKI0VVUDoABVQmuo8FfEYL0wo6/Hpmohuo45OaKeePce8qj59/hTl2DO4fCZKbdDtfyR7vDWZtmSH1Gi1Hhiwhe4aUxJkd+UVbSVRcDYfCst4e4rnhs/BXwWnI2kY48u3c79vLpVj4Ye4uprq9u5XlbaNCx6dzjy7U5oMJnEGad2g73v/AB+aLAYi91DiLcPfGcx1vyt1B5/zodUT4h1S30nTbrULsnwLdOYgdT5AZ7k4Hzr5s4j4hvuIdTa7vpCRkiOIHCxL5Afx71o349ai6Wel6dGxCzO00gBO4XAXP1P0rII60lNGGtun1JGA3PzUuM5p8dN6jx59afXpRCLRrhLWX0HXbe9Ut4QPJKo/WjPUfxHqBW3cQ2ouYYrqAcx6Fh0Knof786+efpX0NwReGbhHSriT3isAU5HXl93f6UoxqnZPSvDun21QNYwGxVckhdOqsKl8P2sV5q8EFyGMR5iwBIzgE1c8WV6OSWBAT3UYP1oTqHD81q3tenSMeT3gUGGX5d6+aCDUObqEA6It13Ce4o0LSo9IkmsIykkQDfmLBhkDBzmqEEjXISNRzdcKN/jRjUr6/uUEE07OnVgAACfXAGaHNbSBCzAKo3NVzPErszG2+ioe/Pq0WQ68t2a1nS0ESSy9WZdt9iSO5xSoLxNrbW0b29jkzEYaUdEHoe5pVKOJ9r7JlS09QWXBAv1CXMCAykFTuCDU/R9LudWuhDbgAfrufyr8f5VWeD9WmS+tNPa0jvY5JOSNGOGUtt1x077/AB2rcbw2eiac91K0cEEK5du7H+J7CmmG4S6umPENmD69v5XamN8brdVG0vhDS7JMzW8d3MfzPMgO/oMbUa9mjCcqRovlgAVi+u/iHrF+7pZSexWxOAIwOcj1bsfhiq02s6oW5jqV6W8/HbP763kOFxRNsxoHouile4fMV9ECGCUlJI15vMDGaHajw7a3VxBO0aSSQNzRlxup9P77Csb0vjrXdLcEXXtSD9S5HPn555vvWrcFcaWPE0fhAezagi5e3Y9R5qe4+4+9D1eEQSC+Wx6jQ6+X+lVJTvYLnUIpHp37R/jVC/ErV/Y54tLtcAlBJM3frsv2z9K0e48QO6xncjKjpXzzxfey3XFWpvIcsk7Rf9nu/wAKy9PgzqSq+YXaNQfzmnvhilFRVl79QwX9eSkxXXrmphuuVUX0yf7+FVpJjsM7nypw3Z5iM96d2svoTmXV34f0e912Ui1UJCv5pX2Uf1qfqXBs62ck+m39vftGQHSPAIP1Pn3p+9a4vPw00r/CG5EZvCuAnu+YJbHbbPzHWvdIhttIja1juso2HknQZLtjYfAb7UipH1ldI6VsgYxri3LYG9jY3PInlbQabrA+IvFzcGbfMDIScrLcgbXceQ6W35cyCPDXCkESB9VVrmZRzPApKog9SN2P0Hxqyte+ypzwJCkDPyhBsV9cfxoLY3kk4HP4ntEpzltgVxTjyM9zzQxxgrhORzzZJ2zWrOZzBZfG8R8SV2KziSZ93X0A2F+g+/VUH8dCX4k08dUFmGB7bu38hWeRr0zWt/jHpTT2GnanArFbcGCU9cA7qT6ZyPmKyxEpvD+wL6lSnNEF7GtPgbUkTHWnOX4VaigxcYr6E4PtTBwlplsRhvAVm9Ob3j++sX4T0Vta1iKAhvZ1PNM3TC+WfM9K+j9LtUWBcgDYYGOgpPjUmWnLBu7T3/6S+teAQxRrayIwdsUZtYyoG5PpT0MCDtUpYwOmfrWMp8Pcw6lUNcLIMdAsHuWnkiDcxyE7A1LOn2pjMZtYCh/VMYxREKPKljHpRwowNlwWGwVO1ngbRdURh7JHbzdniGAP+npSq4Y6HalXPg4z+5Wid42K+Yfwo0rn1x72ZSBbxkx5/abbP05qm/itrT3WoRaZE58C2AeQebkd/gCPqavvC2nvp+iwx3ESxTsWYjlAYDO2TWP8SLJc8SamwBb/ADDqD6BiB9hT7w6wfBtfbU3P1t9lc08aoLuQQYD6UsE0UtdJkkPv+6KM22lwwgZGWrRBhKLsqotlNKfdU1MsNPubK6iu7eVoZ4mDI6nBBq0OkcS9ABQHU9RC5WLBPn1q0MY39yiRdbrwlrNvrOkwXb8guU/RzovZx3HkD1+dYB+JdlNpHGOoxsCI7iVrmJv2lck/Y5Hyo5+GGrTW3FkUDEtBeAo6+oBKn6jHzNavxLwxpvE1gINRiPMvvJMhw8Z9D5enSktXDYnIp4VXjCaoucLscNe3+l8xC4PMOY9699oOTvVz4l/C/W9NmkbTVXUbUbhoyBIB6qf4ZqoTaNqsU5jl029R8/laBgf3Uqcx7dwt/DiFNOzPG8Eef36LROCtSmHB7IpiaOC7ZykhzzZVQNu++TUwXnJEkXtAMMxDzLGu6YNBOFeHdVt4XimtjFIT4uJPy4wNvLJ8qt9jo1008s9zIsDTAo8cKjHKcbZ7VOlo3gEMba5JPrv08l+dvFcEmKY3PLSDMy4sf7dANjtvc3C506eSWQ8izzRH3IJGOAoB71ftJ0yMW6OVDykbuKCabpqQxrFCpVF7VaNMDQAc2ceVNeDwWb3KIwzAY6QiWT5n/QeX8/ZN3dhFcWstvcRrLBKpR0bOGBrDeMOG5OHtUMYDNZy5aGQ9x+yfUf31r6OCJKu1CeIeGrTXdONpeBuXPMrocMjeY2qMVTlPzLVU1Rwna7L5tVfSiWjaPc6tcCO3TEYPvyMPdX+vpWoW/wCHOn20uGSe4IPR22+wFWew0GO2VQYlSJOiKMDFXz10MDczj7bo2XEhltC0kqHwPw1HY2i+HGRCDkswwZG86u0fu/q4FLTrqKS2TkKmLGFZMFSP3VJcwru0ka57swrL1cslXJxPYdEtyucbnfmuoWG3QH0qSDnc1HUKMYIz6U8hyNsVWy43Vjdk4D9fQUifLP8ACuRt/wCaW9X3Nl2yWRn+dKuSQSKVVgrxKqc9n7yM5wMdKyXVrOODWb8Ku/juc/8AUa2fU9o0Priss4utjb61I2ByzASL+4/cGn+BAMiEY5fypYc+8xYeiCY9MVHublLdcsRntTd/fLAuAQW+NVu6uHncljkU8c+yeiO6ev8AUJJyVXZfKhrDmPenSPSuSKpJuvFiu34T6V4upzai6kpAOSMkfrHrj4D/AO1a4GJIUAAmqX+HkQteHLfs0paQ/M4H2Aq3Qt7xOc/OhJhcrP1D80p9lNjTC+leNCjbldvhXiuCcKPn0rokk4Gc+eaG1uqVBns0LbLj400lhzHJG1ExHv0GK76eVT4rgLLllGitljXOBt6U4RgDG1dtu3bauSNv6VC5O66u4pSm6E1NhuRJsdj50zFptxLGrDkQN+XnOCaZmhkgcxyKVcVWcj9AdV4hEpHAiLbEL1+FC7ewleZpr3VJ7qMhh7N4MaQlTnIYYJbr59t6cWQhCjflYEHvVTj4mliuBbQaffXU5JXEEJZc+Rc4UH4n94oOZpaRYXJTGklMcLyeXa+/1Hop1zd6l+jg0u1j0jTkRsxxLCZS2cgIvNyLk7ZPcn40Uspdbu7eLxnbTUjX3WZo5nmbYfpEUYA6n3W79ulBZbi3uGzck28hOCrsAVbuD6/Opdvp0sqt7PdKCOilsFvQdvvWaOOPMhjbGD9/VCPxlznFrIm9jz9b7+qtGnzFHiWWRZH6M6ryg/LJx9aKtkHJwPjVMtIr6O58Fo7iJl6vImUPwYZH3qzWNyskXK0sb8p5SyHOCOxprGx7mh8mhPLorW07w3O7c6qcJB6GvHfb+tNeGM5Qg/A7V4ySDuhX0yCKsJuLFR0K6V+ZwOtKuYo259+lKqmbKpu2qC6vGJVhPPytE/OMd9iMH61n34gyK9iJIcmaDOQN8qev06/WrFxLrHsdjczr7xjjZgPMgbVn9pJI1tH47l2YZJY+dMvC4dUmWUaNBFvO2v0sgWztpqhrhvv/AI+qossjSMWbvTePjVnveHPEYvYyBQeqPnb4Gh50DUAcCJW9fEX+daYscFsI66nkbcOA89EHIFepG8sixxqXdjhVG5J9KOQcN3cjgSvFEP8Alk/arbomj2umANGpeZhvK43Pw8hXMhQ9RiEMY+U3KN6PCbPTbS3Y4aKJVOCOoG9G4CAASRQeGTGP50Qgm2G9USNKz2a5uUWjbbA6mno+uagxSjABqVG399aCc2ymFIALEBQWJ9M068E0aF3jdV8yDQfjTiRuEOGEu7WAT6neMY7dSMquBksR3wO3qKxS1/FPimG+9ql1SWYHfwmVfDPpy4x9MVxkbntLgQB33PsqpJgw5bXW95x1O9PWUYkuog+OUsMignDWuw8S6Ha6pbxiJpgVmiG4jkU4YD06EehqS2sadbXSpLqVlFOjA8jXCBgR6ZqDtuivja6T9gusD494g1PWNdbUL5pVily1pGW92OLOwXy+Pc71r/4X8SXHEXCJN+zPc2Nx4AkZsl0K5GT3I3H0qhcWfhfqdxr091or282mTuZEV5grQgnPLv1AztjO1X7hDSE4a0JLAMrzM5lmddgzEAYHoAB967PV5wGkWA27fgVNNSOLs3PmrOsisCSQDWXXk1+t9c+EqlDK2OVyvc1osLBsk523oVpvD8tyxkK8oJyWOw/rQlU6ldEePr+dkYJJICYgNHbrPL2G+lu1kZHG2Bvn71bNF1C8gggR0Z2TYk5GBmrxaaJa24HiEyN5DYVPSOCIYjgiU+fJk/XrWFqaOm4jnwkjp2/lBDCxxTK3S6i2N+J4s8rZHpXcFtaw28sUNtFBHJksLdfBLHucrg59al+MR0OK69odR1+tMhijgy1teqcZ3hmVuihwGOzAFpAY2/WJkLM3xJOTTi6xOJCGtT4a9ZCyAfTOftTrSxybSINu67Go9xac6loWDD9kjf8ArQ4rZ3kkHN9EvkZLcuJuVON+ZEyuBny70qrFxcGLKFiuOoIIpUybPUlotAT6hUurQ2wLNVUeIZVl0y8Nw2FMbDPXBI2+9AbCUTWkTKd+XB36EUdvbWK+hMEwYqSDgHFD5tIXR3t5Yw3s7k88bPksexA/vtQ/hvHm4W5zKg/pu18joL+Vt0txJjgeKf2geu6dtLWaZvc6UYXSI/D555CMDJIwP31J0aeK4teZAoYEjlB3AztT2oIJ7SaLO5UjY963z8RM8HHp9bi476XCVCqfb5HaKLFb2SReJGFkX9pjn99DZL9Lm7aNdio8utR4YJntDFDlQdjntUFLUadKLi4fLKdx/ChsAxRmKROL9JBy/wAjt9lIymmmbJI64RxZOm21TIZeXG+9CluobpRLbH9G3TI3qRFJvgnNN3s6rQtcHC4RqCc58zU2KbHfrQSKTPepsEmdxml89moiJmZc8faXe8Q8NQJo03hatYO0kKg8plVhhlBPQ/yrAE4V4gkv/Y00e/NwNihgYY9SSMAep2r6KWU/CpcdwzLys7kfHNLg250Kskgy6rK9ckuODOFtI4aiuhBc3bNNe3KHPIGIBCnyxtn/AG+tZ9q0VvaXzw2t0tzEACJF6HbpWo/jxw1frYafrccTPCimGYKCTGCcqT5A5I+OPOsMNwwO9BTuu86r6V4bEUNCx0fO9/O/P82WufhFxFcx6qmjSs8ttc58JSc+G4BO3od9vPFanMjM5wMfvrI/wB0K61fjCHUeUrZafmSRz0LEEKo9c7/AGt+4hgSC6R1AHiDJ+IqcX6nyFZ7xJJFDW5otyAXef+rILYRgSDnAIPUHoRRtpzgeQ2A2oTDOhHMjAg9CDsafSTJ2pDXu482Rh0GiShrnDOeaktNnbI+ZrnxTim8mvN/nUGUYXsqd8T5V4JMdNvWmT174rnPw+tW/BhdyqTzjqacSXfYnP3qDzY/lXokwdztVbqIcl7KplxaQ6ivJIAJezD+NKlYyZmUUqsjbI0Wuh5Im31CqWm2WweUfXFe6zpEV8yyvM0QiUg4XIxV2bSoR7iMw+QxTR0kJu/Kyn02pM7DXSDLbRL6mnErC14uFSOHYLS/glijtgrxMWT3iGweh5s5HTfFNWcE1la38uoJJEMhhz9zvsPPtV5itILcnwIYoyepVAuftQfiB4hA4uArrj8pAOavhiq6RrHRvN2XsP7ddNkpGGGZrRpmF9hYHpfyVQ0/WIViKScizsSAPP4VXOITJfMTGcOO37Q8vjUCWJ9UvrloFNuVPNHGQQMZ8+xqZKsq2ye2PGlxnlBDfn/rS6GuqaebitcWv6j6389ygxBI1nCnG3NBdN1KbS7jdGeEn9JH5HzHrVzsL2C+gE1tIHTvjqD5Hyqn3bRTPiX9HONubz+P86l6HaSw3XjKnhQ4OOV9ifhnf51ssP8ZFkQir2lzh/cNyNdT32A7Xv3nSTSwv4R1arpFJjYYFEIX93rQGO4wffz8qnQ3SnGCN60LK2lxFt6d4Pbn7brRQTtad0XV8dPtRjhpBcaogbcIDJjscdPuarInxjz+FTNM1GWyuknhxlezdCPKvfCOykDdEyThy1K4SKW3eK5RZInUq6uoYMD1BB6isl4q4O4IsbuKd+H7mdpZOUxWZkIH+4gMAq/DHwqy3PEk92vLDDyH/AJZoeRKW55s8x3360CYGU7eJUuyhTpKueN9oCRfexIv7KxaPdaZo+nJa6VaJbwLusUScu58/M+u9AuMkv9V0q6e3mMU/IcImfeUDdQexNcYd8bnHpT5M8ichbI7+dLPj4Xh7I2mxFgfzZWyYdJM39R+p36+/Modw1DJBpFnBMvhvHEqlM/lwKskMWV22FD4IHUjYUZtFJAGDQlNTiNoCM4QhjbGDcAAeybEJPSl4R8uvlRBYwa68KjQ1U5whhhwdh9qaeM/OirQ+VNPD6V2ykHAoS6EE+flTZGNsH4CiMkPpkedRpI/P99esuFvReaeT7Qvx7UqVkuLhfjSqOUKp26s/h8rZ/jXvoRmnyMnem3Xb0qBYG7IQ6oTqBW1R5H2VRnNZtruoC8nJRv0ZOxrQuKonudJmjh3kAz7vcd6o6aDZeCnja3aRPy5MZO6nAOD8yf7zS+odI5/DYLqVJdklg26r5Y0N1PT0vXRmldSgwANxVwm4a8R2GnahZ3SjoVlUH9Y9M57D/u8hQHVNEu4LpI7+7utPj25lhtldpB5q7NgHy2NKTQyzylrhbvy+irkoziExhc2xPny8lU3tX1OWZXV45VGUZlxntg+v9aFG81DQ5+WQN4Z7HcGrff3kGnf6zXi2meSO6uYSFk9OcAKW88etKS1F5ATH4Wqxv+WKyiZnj23DZ2PXqDUZMMniOQfMPzZC1nhqppG3BuOv5zQgcQwXWnyNA3h3OAoB7EnGflnNR72TOv2McjHw1UsoJ6Hn5fsqiircBzXlpM+lQytLjKxSKUP/AMsVabHSdLsFt5tThju9SjPPzc2Ujc9eXYZ333oyGjL6MGIfOHuzDnazbf8A0kM9HUSPAdp3Kplha8RzWOsPDFfmZX/R4zjHMPyZ26Z6U/cW3EkukRtAl8syyIXBJRiuCCPrWiR6jJfXKQxs658zsBRF7OM7sWYj1qLn1URDHvcPU/a6aU2CmUf1T+eqHcG3EttpHs+pI8VxG599zkuCc5zv54+lFWcXUpdQeXoM1E9kAcYYqPgDRa0twvKq7jHXrTIyyVETYXbBaako20jBrc7Lq3tw3YGiEVoO4+1OQRDb+dTokxtvRUUIaF2SUqOloMD3aeS2Axt8M1KVQO29dco7nrRACGMpKaCjpmveUY64p3HlmvMGuqGZN8gI2ptotqfI+vrSIry6HWUCSPbbFRJYsDp/Si0idwCKiTR+hrivZIh9tH/mBjzpVIiT9OpFKvLz91YPh1puT8p/jXZPbauHxj+tRcgghjg+05PT1rLOJYfZ9RmXooYgfWtUc5uMAVR+JY4rm5lJI3Y7j41n6qd0Ls7d7qbap9I4SR7qkk77Yo5p3FF5aWy280cN7AjBkW4UsUIydiN+/eh1zacjbNtU+04U1W6hMogMSdml93OzH/8AOPiR50DT1E75uJGLuKGbXSSVHGIu4+ylNxVM1pFBY2UNmyEEPG7EAcoGOUkjt3z189y7w7pJuJ+aGC3tz1ZreBIh8+UCntO4T5XTmv7NnOMorBmzttt33P8AZq52VmtlaCJGHN1Y+Zo2aslzWmFh2Tf4t77tLQPL/tKJFtYRHHk46sTuarGt6Cs0jz2jiOQ7lG/KT5+lWSZuXuD8DQ+d8nBaXA3Phpnb41VSyziXiRafZDvhbKLOCB6bos1pzXFw6DI5VCtnr3qSUmRvdbNO3M4jBYeKYQdpCCQfmNq9RWbBiImLfqRqcj5nrTJrZJ355NSioYBE3suUMgPvqc0VsiO60xApwMqy+jKR++iVtGNtsUwjjDVfI8ZVNtwvbI9MVKeSKCPmlkSNfNyFH3riFBgbUB4ziiuhpiMLaZbe9SWaKVhyhSjqGYfs8zLVrjlF0A0Ne8NcbBWEXNuER/HhCuMqecYbA7edee3Wy3EkDyhHjRXcnIUBiQu/TciqjHw1Yx3mlvcz2NxFa3E8txE2FjDzD3QqHIABAwD8a81fh2HULvUUS4s1tbueyiEay8uEiPvxgDocHYD7VDM5WCOlLrGTTy/8rfbVXCa/soLWS4muYUgjyHkLjlUjqM+fp1r03dt7MlwbiIQOAVkZgoOem586p93w/ZzaHxBZW8unL7fKZbMBlCKFjQbAdCCrbjOM5rzXdCt76LR3sJbC1is0kd7RJI+Qq2AXXmQqSCDuV7ncV4vcuNipSQOJue3S/wB9FcpZoYSomlijL9AzBc9tvqPrXJurZIVka5hWJ91cuMEehqjanwpZ3Emlo15aT2dlbRowuJhzBTPGxPTGCnMo6dQO9dXOjRxtw/bAae5tYriKTxMPFFNIFIYr6kPgbeVcMjuim2GlIFpddfoD352V5lmij5DJIi85CrzHHMT0A8zTcq7b96pl3oEVtJo50qe0uLmxRbWN7yZWQMGyfc5T73qpBGw7VIl1rWbvjqGx0+3T/A41zLd8uVlPJk8rdDglRgd813idULUPhgDCx2bMbfXp0VjRf0opU6B+kHxpVYpOKmtLg4pp3znf70zMreISNh3obcazZ2zMiyBpFG5J2FDyStYLvNkI97Wi5K4169FlCwH+o4+lUI6nBHfJLdRePErZZAcZFWPU5v8AEIZAd5KpF/p1zG5OMigXU8M7OLdNaGkpaqAvmdYoqeJ7GEf5bRLbnAwGlkL/AKpXcd+v9negWo6rcajcmfUrnm5j+u/ujckAZ6dTgVDkikTPOp+dTdGDLchlCE9PfQMPoc12CIujLS3Q+QTOioGNgeWsuTsVeOCLeJ4DcoQyrspHnVkkPUn/AMU3p/P/AIbAZGBZhk7YHp9sUpMj9k+oINIaiJ7ZMhBSXKQTdR5JOYkRkM/kTj70PuboRHld15QcFGb3T6etTLqTKhWKun+3K0Oe4WH/AEIYo2wQWI5iR8TWlo3M4QaOSYUwBFvz89FwSyP4ns2nNC3vOiOd/IFSd8elexlOYeDEsQ7hCf4k1BB3qZbi3YDnicyZ/OspXbyxRLWhEujLBcaonbnPUk+tFLYZ64oTbdds0VtiNqtCCnROHf5elcyWNpO0jT2sEjOAGLxhiwHQEnr866hOR1ycU8DvXUreAd1WdWstQGoXT2Gn28qOgePmEXhl1RipcH3i3MQM9AMHzp5rLUYLxHSxsJ4fGidisKqcnm8SRQW2bdBnJ2FWMf3mu1zVXC1vdTMwsBkb7Kp6db6k0dl4ul6ZHzyFCvsxUxKVJcjcgdMdubPyM3XNPmElsuladbgRxyByY4+Rk5GCx4645ypxsNutWAn40siuiOwtdQMjc2YMHlbRVHULPUZrSeC106w8WSzceI8Kgh15hGvdTn3DjJC8pz1Fei21XnJl0ywckc/MIUy7hmCl/f2IXlIwTu2MjrVs+P22rmucLuVMTNtbht9kJ0uyDWkUl/p9pFd85lZY4wVVyeoPUnGPe6nyHSlPo1m8lpIkXgG1cvGIsKN+owPOif8Ae9cNsNqkWNcMrtVU5rXm9gmB/qClXgP6QUqmrSqXq+tXskqCFXeMb4B2NCdVtDcANDyo+cnJNKlWEqZXzEh5ukEreJma43T8LNHbJ48nvAYLDzobqF5yMcOSPWlSo+elZHQsmb+4pnPSsjoWzNvmQS8vOc9s+lStBZXuVMsaSp+y4JFKlWgpHEwsv0W/oBegYT/xWtQwn2SDkUAeGu2+21NSwSHtk+tKlU3tHEa62qyechyH3FrKckL8xQ6awuD+ofhjNKlQpaOJdTbIc10wNOuM/kP0qVDYzjGVNKlTADRMDUPsiFvayjqu1EreJwNwaVKpIGWQlEIVYdc1IC4PSlSrqAcdV0Mj510B8RSpV5VldDPxpevWlSry4vDmuSM/1pUq8uhcEHv964dT2+1KlXlIFMBDzgmlSpV5WEr/2Q==",
 	}
 
 	// Run Test
@@ -146,8 +145,7 @@ func TestSongDataFromDocument(t *testing.T) {
 	songData := songDataFromDocument(document, testId)
 	if  songData.Id != expectedSongData.Id ||
 		songData.Name != expectedSongData.Name ||
-		songData.Artist != expectedSongData.Artist ||
-		songData.Image != expectedSongData.Image {
+		songData.Artist != expectedSongData.Artist {
 		t.Errorf("song data for song %s did not match expected data of %+#v got %+#v", testId, expectedSongData, songData)
 	}
 }
@@ -156,6 +154,8 @@ func TestSongDataForClient(t *testing.T) {
 	// Setup test
 	const musicDetailDir = "./test_data/music_detail"
 	const musicDetailUri = "https://p.eagate.573.jp/game/ddr/ddra20/p/playdata/music_detail.html?index={songid}"
+	const jacketDir = "./test_data/jacket"
+	const jacketUri = "https://p.eagate.573.jp/game/ddr/ddra20/p/images/binary_jk.html?img={songid}&kind=1"
 	songIds := make([]string, 0)
 	uriMapping := make(map[string]string)
 	files, err := ioutil.ReadDir(musicDetailDir)
@@ -167,6 +167,8 @@ func TestSongDataForClient(t *testing.T) {
 		uri := strings.Replace(musicDetailUri, "{songid}", file.Name()[:separator], -1)
 		songIds = append(songIds, file.Name()[:separator])
 		uriMapping[uri] = fmt.Sprintf("%s/%s", musicDetailDir, file.Name())
+		jacketUri := strings.Replace(jacketUri, "{songid}", file.Name()[:separator], -1)
+		uriMapping[jacketUri] = fmt.Sprintf("%s/%s.jpg", jacketDir, file.Name()[:separator])
 	}
 
 	c, s := testServerAndClient(uriMapping)
@@ -178,18 +180,19 @@ func TestSongDataForClient(t *testing.T) {
 			Id:           "1PoOQPd0D01Q9O0doiQQQ8D8Q096bDq9",
 			Name:         "printemps",
 			Artist:       "Qrispy Joybox",
-			Image:        "/9j/4AAQSkZJRgABAQAAAQABAAD/2wBDAAYEBQYFBAYGBQYHBwYIChAKCgkJChQODwwQFxQYGBcUFhYaHSUfGhsjHBYWICwgIyYnKSopGR8tMC0oMCUoKSj/2wBDAQcHBwoIChMKChMoGhYaKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCj/wAARCADIAMgDASIAAhEBAxEB/8QAHAAAAQUBAQEAAAAAAAAAAAAABQADBAYHAgEI/8QAPxAAAgEDAgQDBgIIBQMFAAAAAQIDAAQRBSEGEjFBE1FhFCJxgZGhBzIjQlKxwdHh8BUkM2JygpLxFlOissL/xAAcAQACAwEBAQEAAAAAAAAAAAAEBQIDBgEABwj/xAA2EQABAwIEBAQFAwQCAwAAAAABAAIDBBEFEiExE0FRYQZxgZEUIqGx8CMy0TNCwfFS4WKSwv/aAAwDAQACEQMRAD8A0/FOQQS3D8kCNIx7KCalaNYnUL1YjkIBzOw7Cr9bwxW8SxwxhEG2FprPVCM5RqUqp6XijMdlRJNE1GJOZrViP9uGP0FQCpViCuCNiCK07IA86g6lpttewurxosh6SAbg/GqGVxH7wiH0LSPlVFsLcXN3HCz8gY/mq42Ok6ZEyhYTJJ5yjm/pQHR9Kie7l9vzywty+Gp/Mfj5Vbo7iBFCr7qjyqVTKSbMKhSxAC7wE/HFHGMRoi/8VxXf0+lVTVb64/xEw2njTI5GX51CxgjJ2BB7Y2B6g771HtOMLG3sbua6v4XgsffuJFbn5V94H1Jyp9SRWZOLxtmdFI0ixtfdOWUr3tDmC6s+rWftlo8IWPmYYDOM8vqKCRcJqP8AVumP/BcUTtte0660+G9tLmOa1mUPHIrfmHoOvyqHJxGik8kOVHQs2PtWgh42W0eyU1T6eN36xsQvP/Strjeab03H8qCaros9lN7p8SE7K2wP0z96m3HEsrfl5UH+3+dNy8QaNBh7ya6mfyCf3++io21AOuvZAOqaST5WEDveyECzmY4CkntgUp7G5hTmlgkVfMqQKOLxvoMMYMXig4/KkGD99vvQu+/EZMkWFix8mmbH2H86vY2qedI/fRcc6mYLukHpqh2dvSu0jaQ4UE0Ke7uNVvHu7lVVm68g5QK5mveX9HAuAO+aM+HdtzS410YNgj8VvGHXxpAqk7/CjAXRBHyAnmPRzJk/SqB7YkUgknKvg55WbY/GiMPFmmwjDaNbyeZyf45quSild+258tFdDXxf3WHnf+EWjJlcpEEz1HMQMj50/HZTS4y8S+nOCcfKo+n/AIgabbEKmmeAD1MYGak3/FHCt/GpuoZfFJ/NHHhlPmSMZ+9UuhqGusYzb3RLZadzbiQX9kQi0ezfAaUr6kkn7Cj2jWcFhE0UU/icxzud6yqTiaS2vHFhcSzWgPuC5ALEev8AQ0Y0njC2myt+RbvnZkBKn+VQnoKjLrqPzkrIK+nzWGh/Oa08YoDxWzGxdTbFkGCHxnlPn6UHPHFlbLyibxx2wpJrwcd2EmVkBMbDDKU6jyoSOinY4OyFFyVtO9pbnCFWtpPdMRbRNJjrgdKVDdO1+XTb2aTTyWtnY4ikbO2dtx0OKVMZIagH5ACEujmpyPnJBVs4QIWW5O2cKP31aPEA7ioV6LGygZolhhYd1UDm9PM0PW9MgDo2R6Gs9NITM4kWB29lpKOEcMMvqEe5z2pppux2oRLeM8RCHlcdD5GvIb5VtzNdERFdmB8/TzqL3ABFOhyNLnbKt8S36xa1cLHzFxy55dt+UUK8S8uTgEqD611qEst5xDHLA8QtyWe4LkbL2z/TvUq+HNbSw2syLPJETH72Mjpkfzp5FM2njaxzfmACzpoJqx3EicMhJ+6happ01zoklql5LbTlwyyLIwU7YIYAjIwTt54qBxFwbwbJoot/FuTd8/MLi3kKu6fssGyOwJ26jIxnFCLCK+iiliZZi5cAIc5GM5Pp2+PyqfFpd3LvM6xjyPvGg6mHD2ROdUTCPObnXU+Q1Psrm4niVBJ8LSHM1uxtax7nt0Kl6fPZ6Tp8FhYBltoF5UUnJx5k+dRb3iSKDIG5qVHpECD9IzyH44x8MV6NH09Dn2WJz/vHN+/NKMS8T0zYxDQX6X207c/UhA0mFcSV09f8xJva+5O90Et+KHvZzDbQySP1xGMkCoWoahcXT+HCkjt5ICT9qtgitLJJHCQQIcc7BQgPxO1QZdXtRaPLppiu1R/DbwXBVD646UugxnEqxnw0euawvoPQu0380yFDQ07uO2LbzI9kFsLfU2B8SyuhGMjxGhYrnyLAHHzwKkaDY6jfasY3geKPlz+kUgD1zUiF7/ULkIbh4wT+SLIx860PQLFrKIDneU46Oc0+OHYpRsY41FiNctyR5flx3SmtfTVb2hseXra2v+UF8GO00prq9sriONVJOWwevlQ3TYINbDNZW8oiB3d8hfqDj5VetQjW6Tw50DRn8yNuDQ7VdT0/QdP8e+mjtrdPdUY3PooHU/CpQCpqbmV7m7WLXEEnmbaix7K6mwuNhFwHDu0XQuPhGyIzNzM3+0kY+ea5uOErQrm3wT5P/MVTtX/FaRpWXR7FBGOklySSf+lTt9TUKz/FDV45Qbq2s54+6qpQ/I5P7qZGjkc3Lnd/7uv902GFst/Tb7BWO50eK3cpNbcjDzJ/nUdtOte0f3NWnR9W03i7S2e2ysiYDow9+Jv4j170EuYntp3ilGGU/WsfijsSw592zvLTt8x9jqhX0UANnRj2CAXelz5doCvL2XJyai/4feRrzcq9Mn3htViJriTLIwDFCR+YDcetIZa+qmeJJJXFw2JJ0U42sibkY0AHsqWdbjEnhxrI8mewwKnx3r8m/KTTes6NMA1w+pFlVc/5g438gegqux3cjFliUycoLEqDsB1NPWeIa59iZToLf701Pc6o2HCcPkaSxg77/norRHeOH94gj0FKqxFfucnlPKMZONh5Uquh8QV0Tcokv52P3XZMApJHXy28tFvnFyWVlDhNQllv9vcOG28ye1VW11KezkZ4pinN+bm3B+RoDc6qzSNyAlepZs5NQ5LtmOftWoZimGNYYppAbb6b+Wiz8lPXcQSRMy37/fVW6740ks4SyxLO3ovT161V9V4mvNRkW4cTo6jClBgAHtjoa70p7K7nWOe7ELk4CEEZ+fSrjbWdvagCGJVPmRkn50gxjE6KjkvROuSNg3Y9cx1HoPUL3w2I1Qy1Li0d/wCEB4ekdEuDrFpcRxsF5g8Z5XG+Qdjse+aN2cWgIkr6JFJEZp2lkkMvifmx7g22G3Snbmdba3lnfPJEpdsbnAGTVBu+KLRbEi1S9iuJbkzo8ScniZJ2znvnB60FSYtiNeC4MzHQX2Hr6bp3RyMoIuCXaC51563/ADRaHM3NISM79qbJ+fwoZpf+Ii2tGuirF0BlVxyvG2OmRsfsfU0RdgqlmOANyT2rJzFz5XF25J910P4mtl4dvSuWYAZJwPM9Kr2oa/zMUsyqp/7h6n4CubFku7e7e61ERcighWBPiHfH9+tMRhjoouNUnK3sCTr2Cf0+ATPZxJjlHlc+yNTCzu1EU8drdJnPhyosqk/8TkZp8yh0jCiNY41CKI0VVUDoABVQmuo8FfEYL0wo6/Hpmohuo45OaKeePce8qj59/hTl2DO4fCZKbdDtfyR7vDWZtmSH1Gi1Hhiwhe4aUxJkd+UVbSVRcDYfCst4e4rnhs/BXwWnI2kY48u3c79vLpVj4Ye4uprq9u5XlbaNCx6dzjy7U5oMJnEGad2g73v/AB+aLAYi91DiLcPfGcx1vyt1B5/zodUT4h1S30nTbrULsnwLdOYgdT5AZ7k4Hzr5s4j4hvuIdTa7vpCRkiOIHCxL5Afx71o349ai6Wel6dGxCzO00gBO4XAXP1P0rII60lNGGtun1JGA3PzUuM5p8dN6jx59afXpRCLRrhLWX0HXbe9Ut4QPJKo/WjPUfxHqBW3cQ2ouYYrqAcx6Fh0Knof786+efpX0NwReGbhHSriT3isAU5HXl93f6UoxqnZPSvDun21QNYwGxVckhdOqsKl8P2sV5q8EFyGMR5iwBIzgE1c8WV6OSWBAT3UYP1oTqHD81q3tenSMeT3gUGGX5d6+aCDUObqEA6It13Ce4o0LSo9IkmsIykkQDfmLBhkDBzmqEEjXISNRzdcKN/jRjUr6/uUEE07OnVgAACfXAGaHNbSBCzAKo3NVzPErszG2+ioe/Pq0WQ68t2a1nS0ESSy9WZdt9iSO5xSoLxNrbW0b29jkzEYaUdEHoe5pVKOJ9r7JlS09QWXBAv1CXMCAykFTuCDU/R9LudWuhDbgAfrufyr8f5VWeD9WmS+tNPa0jvY5JOSNGOGUtt1x077/AB2rcbw2eiac91K0cEEK5du7H+J7CmmG4S6umPENmD69v5XamN8brdVG0vhDS7JMzW8d3MfzPMgO/oMbUa9mjCcqRovlgAVi+u/iHrF+7pZSexWxOAIwOcj1bsfhiq02s6oW5jqV6W8/HbP763kOFxRNsxoHouile4fMV9ECGCUlJI15vMDGaHajw7a3VxBO0aSSQNzRlxup9P77Csb0vjrXdLcEXXtSD9S5HPn555vvWrcFcaWPE0fhAezagi5e3Y9R5qe4+4+9D1eEQSC+Wx6jQ6+X+lVJTvYLnUIpHp37R/jVC/ErV/Y54tLtcAlBJM3frsv2z9K0e48QO6xncjKjpXzzxfey3XFWpvIcsk7Rf9nu/wAKy9PgzqSq+YXaNQfzmnvhilFRVl79QwX9eSkxXXrmphuuVUX0yf7+FVpJjsM7nypw3Z5iM96d2svoTmXV34f0e912Ui1UJCv5pX2Uf1qfqXBs62ck+m39vftGQHSPAIP1Pn3p+9a4vPw00r/CG5EZvCuAnu+YJbHbbPzHWvdIhttIja1juso2HknQZLtjYfAb7UipH1ldI6VsgYxri3LYG9jY3PInlbQabrA+IvFzcGbfMDIScrLcgbXceQ6W35cyCPDXCkESB9VVrmZRzPApKog9SN2P0Hxqyte+ypzwJCkDPyhBsV9cfxoLY3kk4HP4ntEpzltgVxTjyM9zzQxxgrhORzzZJ2zWrOZzBZfG8R8SV2KziSZ93X0A2F+g+/VUH8dCX4k08dUFmGB7bu38hWeRr0zWt/jHpTT2GnanArFbcGCU9cA7qT6ZyPmKyxEpvD+wL6lSnNEF7GtPgbUkTHWnOX4VaigxcYr6E4PtTBwlplsRhvAVm9Ob3j++sX4T0Vta1iKAhvZ1PNM3TC+WfM9K+j9LtUWBcgDYYGOgpPjUmWnLBu7T3/6S+teAQxRrayIwdsUZtYyoG5PpT0MCDtUpYwOmfrWMp8Pcw6lUNcLIMdAsHuWnkiDcxyE7A1LOn2pjMZtYCh/VMYxREKPKljHpRwowNlwWGwVO1ngbRdURh7JHbzdniGAP+npSq4Y6HalXPg4z+5Wid42K+Yfwo0rn1x72ZSBbxkx5/abbP05qm/itrT3WoRaZE58C2AeQebkd/gCPqavvC2nvp+iwx3ESxTsWYjlAYDO2TWP8SLJc8SamwBb/ADDqD6BiB9hT7w6wfBtfbU3P1t9lc08aoLuQQYD6UsE0UtdJkkPv+6KM22lwwgZGWrRBhKLsqotlNKfdU1MsNPubK6iu7eVoZ4mDI6nBBq0OkcS9ABQHU9RC5WLBPn1q0MY39yiRdbrwlrNvrOkwXb8guU/RzovZx3HkD1+dYB+JdlNpHGOoxsCI7iVrmJv2lck/Y5Hyo5+GGrTW3FkUDEtBeAo6+oBKn6jHzNavxLwxpvE1gINRiPMvvJMhw8Z9D5enSktXDYnIp4VXjCaoucLscNe3+l8xC4PMOY9699oOTvVz4l/C/W9NmkbTVXUbUbhoyBIB6qf4ZqoTaNqsU5jl029R8/laBgf3Uqcx7dwt/DiFNOzPG8Eef36LROCtSmHB7IpiaOC7ZykhzzZVQNu++TUwXnJEkXtAMMxDzLGu6YNBOFeHdVt4XimtjFIT4uJPy4wNvLJ8qt9jo1008s9zIsDTAo8cKjHKcbZ7VOlo3gEMba5JPrv08l+dvFcEmKY3PLSDMy4sf7dANjtvc3C506eSWQ8izzRH3IJGOAoB71ftJ0yMW6OVDykbuKCabpqQxrFCpVF7VaNMDQAc2ceVNeDwWb3KIwzAY6QiWT5n/QeX8/ZN3dhFcWstvcRrLBKpR0bOGBrDeMOG5OHtUMYDNZy5aGQ9x+yfUf31r6OCJKu1CeIeGrTXdONpeBuXPMrocMjeY2qMVTlPzLVU1Rwna7L5tVfSiWjaPc6tcCO3TEYPvyMPdX+vpWoW/wCHOn20uGSe4IPR22+wFWew0GO2VQYlSJOiKMDFXz10MDczj7bo2XEhltC0kqHwPw1HY2i+HGRCDkswwZG86u0fu/q4FLTrqKS2TkKmLGFZMFSP3VJcwru0ka57swrL1cslXJxPYdEtyucbnfmuoWG3QH0qSDnc1HUKMYIz6U8hyNsVWy43Vjdk4D9fQUifLP8ACuRt/wCaW9X3Nl2yWRn+dKuSQSKVVgrxKqc9n7yM5wMdKyXVrOODWb8Ku/juc/8AUa2fU9o0Priss4utjb61I2ByzASL+4/cGn+BAMiEY5fypYc+8xYeiCY9MVHublLdcsRntTd/fLAuAQW+NVu6uHncljkU8c+yeiO6ev8AUJJyVXZfKhrDmPenSPSuSKpJuvFiu34T6V4upzai6kpAOSMkfrHrj4D/AO1a4GJIUAAmqX+HkQteHLfs0paQ/M4H2Aq3Qt7xOc/OhJhcrP1D80p9lNjTC+leNCjbldvhXiuCcKPn0rokk4Gc+eaG1uqVBns0LbLj400lhzHJG1ExHv0GK76eVT4rgLLllGitljXOBt6U4RgDG1dtu3bauSNv6VC5O66u4pSm6E1NhuRJsdj50zFptxLGrDkQN+XnOCaZmhkgcxyKVcVWcj9AdV4hEpHAiLbEL1+FC7ewleZpr3VJ7qMhh7N4MaQlTnIYYJbr59t6cWQhCjflYEHvVTj4mliuBbQaffXU5JXEEJZc+Rc4UH4n94oOZpaRYXJTGklMcLyeXa+/1Hop1zd6l+jg0u1j0jTkRsxxLCZS2cgIvNyLk7ZPcn40Uspdbu7eLxnbTUjX3WZo5nmbYfpEUYA6n3W79ulBZbi3uGzck28hOCrsAVbuD6/Opdvp0sqt7PdKCOilsFvQdvvWaOOPMhjbGD9/VCPxlznFrIm9jz9b7+qtGnzFHiWWRZH6M6ryg/LJx9aKtkHJwPjVMtIr6O58Fo7iJl6vImUPwYZH3qzWNyskXK0sb8p5SyHOCOxprGx7mh8mhPLorW07w3O7c6qcJB6GvHfb+tNeGM5Qg/A7V4ySDuhX0yCKsJuLFR0K6V+ZwOtKuYo259+lKqmbKpu2qC6vGJVhPPytE/OMd9iMH61n34gyK9iJIcmaDOQN8qev06/WrFxLrHsdjczr7xjjZgPMgbVn9pJI1tH47l2YZJY+dMvC4dUmWUaNBFvO2v0sgWztpqhrhvv/AI+qossjSMWbvTePjVnveHPEYvYyBQeqPnb4Gh50DUAcCJW9fEX+daYscFsI66nkbcOA89EHIFepG8sixxqXdjhVG5J9KOQcN3cjgSvFEP8Alk/arbomj2umANGpeZhvK43Pw8hXMhQ9RiEMY+U3KN6PCbPTbS3Y4aKJVOCOoG9G4CAASRQeGTGP50Qgm2G9USNKz2a5uUWjbbA6mno+uagxSjABqVG399aCc2ymFIALEBQWJ9M068E0aF3jdV8yDQfjTiRuEOGEu7WAT6neMY7dSMquBksR3wO3qKxS1/FPimG+9ql1SWYHfwmVfDPpy4x9MVxkbntLgQB33PsqpJgw5bXW95x1O9PWUYkuog+OUsMignDWuw8S6Ha6pbxiJpgVmiG4jkU4YD06EehqS2sadbXSpLqVlFOjA8jXCBgR6ZqDtuivja6T9gusD494g1PWNdbUL5pVily1pGW92OLOwXy+Pc71r/4X8SXHEXCJN+zPc2Nx4AkZsl0K5GT3I3H0qhcWfhfqdxr091or282mTuZEV5grQgnPLv1AztjO1X7hDSE4a0JLAMrzM5lmddgzEAYHoAB967PV5wGkWA27fgVNNSOLs3PmrOsisCSQDWXXk1+t9c+EqlDK2OVyvc1osLBsk523oVpvD8tyxkK8oJyWOw/rQlU6ldEePr+dkYJJICYgNHbrPL2G+lu1kZHG2Bvn71bNF1C8gggR0Z2TYk5GBmrxaaJa24HiEyN5DYVPSOCIYjgiU+fJk/XrWFqaOm4jnwkjp2/lBDCxxTK3S6i2N+J4s8rZHpXcFtaw28sUNtFBHJksLdfBLHucrg59al+MR0OK69odR1+tMhijgy1teqcZ3hmVuihwGOzAFpAY2/WJkLM3xJOTTi6xOJCGtT4a9ZCyAfTOftTrSxybSINu67Go9xac6loWDD9kjf8ArQ4rZ3kkHN9EvkZLcuJuVON+ZEyuBny70qrFxcGLKFiuOoIIpUybPUlotAT6hUurQ2wLNVUeIZVl0y8Nw2FMbDPXBI2+9AbCUTWkTKd+XB36EUdvbWK+hMEwYqSDgHFD5tIXR3t5Yw3s7k88bPksexA/vtQ/hvHm4W5zKg/pu18joL+Vt0txJjgeKf2geu6dtLWaZvc6UYXSI/D555CMDJIwP31J0aeK4teZAoYEjlB3AztT2oIJ7SaLO5UjY963z8RM8HHp9bi476XCVCqfb5HaKLFb2SReJGFkX9pjn99DZL9Lm7aNdio8utR4YJntDFDlQdjntUFLUadKLi4fLKdx/ChsAxRmKROL9JBy/wAjt9lIymmmbJI64RxZOm21TIZeXG+9CluobpRLbH9G3TI3qRFJvgnNN3s6rQtcHC4RqCc58zU2KbHfrQSKTPepsEmdxml89moiJmZc8faXe8Q8NQJo03hatYO0kKg8plVhhlBPQ/yrAE4V4gkv/Y00e/NwNihgYY9SSMAep2r6KWU/CpcdwzLys7kfHNLg250Kskgy6rK9ckuODOFtI4aiuhBc3bNNe3KHPIGIBCnyxtn/AG+tZ9q0VvaXzw2t0tzEACJF6HbpWo/jxw1frYafrccTPCimGYKCTGCcqT5A5I+OPOsMNwwO9BTuu86r6V4bEUNCx0fO9/O/P82WufhFxFcx6qmjSs8ttc58JSc+G4BO3od9vPFanMjM5wMfvrI/wB0K61fjCHUeUrZafmSRz0LEEKo9c7/AGt+4hgSC6R1AHiDJ+IqcX6nyFZ7xJJFDW5otyAXef+rILYRgSDnAIPUHoRRtpzgeQ2A2oTDOhHMjAg9CDsafSTJ2pDXu482Rh0GiShrnDOeaktNnbI+ZrnxTim8mvN/nUGUYXsqd8T5V4JMdNvWmT174rnPw+tW/BhdyqTzjqacSXfYnP3qDzY/lXokwdztVbqIcl7KplxaQ6ivJIAJezD+NKlYyZmUUqsjbI0Wuh5Im31CqWm2WweUfXFe6zpEV8yyvM0QiUg4XIxV2bSoR7iMw+QxTR0kJu/Kyn02pM7DXSDLbRL6mnErC14uFSOHYLS/glijtgrxMWT3iGweh5s5HTfFNWcE1la38uoJJEMhhz9zvsPPtV5itILcnwIYoyepVAuftQfiB4hA4uArrj8pAOavhiq6RrHRvN2XsP7ddNkpGGGZrRpmF9hYHpfyVQ0/WIViKScizsSAPP4VXOITJfMTGcOO37Q8vjUCWJ9UvrloFNuVPNHGQQMZ8+xqZKsq2ye2PGlxnlBDfn/rS6GuqaebitcWv6j6389ygxBI1nCnG3NBdN1KbS7jdGeEn9JH5HzHrVzsL2C+gE1tIHTvjqD5Hyqn3bRTPiX9HONubz+P86l6HaSw3XjKnhQ4OOV9ifhnf51ssP8ZFkQir2lzh/cNyNdT32A7Xv3nSTSwv4R1arpFJjYYFEIX93rQGO4wffz8qnQ3SnGCN60LK2lxFt6d4Pbn7brRQTtad0XV8dPtRjhpBcaogbcIDJjscdPuarInxjz+FTNM1GWyuknhxlezdCPKvfCOykDdEyThy1K4SKW3eK5RZInUq6uoYMD1BB6isl4q4O4IsbuKd+H7mdpZOUxWZkIH+4gMAq/DHwqy3PEk92vLDDyH/AJZoeRKW55s8x3360CYGU7eJUuyhTpKueN9oCRfexIv7KxaPdaZo+nJa6VaJbwLusUScu58/M+u9AuMkv9V0q6e3mMU/IcImfeUDdQexNcYd8bnHpT5M8ichbI7+dLPj4Xh7I2mxFgfzZWyYdJM39R+p36+/Modw1DJBpFnBMvhvHEqlM/lwKskMWV22FD4IHUjYUZtFJAGDQlNTiNoCM4QhjbGDcAAeybEJPSl4R8uvlRBYwa68KjQ1U5whhhwdh9qaeM/OirQ+VNPD6V2ykHAoS6EE+flTZGNsH4CiMkPpkedRpI/P99esuFvReaeT7Qvx7UqVkuLhfjSqOUKp26s/h8rZ/jXvoRmnyMnem3Xb0qBYG7IQ6oTqBW1R5H2VRnNZtruoC8nJRv0ZOxrQuKonudJmjh3kAz7vcd6o6aDZeCnja3aRPy5MZO6nAOD8yf7zS+odI5/DYLqVJdklg26r5Y0N1PT0vXRmldSgwANxVwm4a8R2GnahZ3SjoVlUH9Y9M57D/u8hQHVNEu4LpI7+7utPj25lhtldpB5q7NgHy2NKTQyzylrhbvy+irkoziExhc2xPny8lU3tX1OWZXV45VGUZlxntg+v9aFG81DQ5+WQN4Z7HcGrff3kGnf6zXi2meSO6uYSFk9OcAKW88etKS1F5ATH4Wqxv+WKyiZnj23DZ2PXqDUZMMniOQfMPzZC1nhqppG3BuOv5zQgcQwXWnyNA3h3OAoB7EnGflnNR72TOv2McjHw1UsoJ6Hn5fsqiircBzXlpM+lQytLjKxSKUP/AMsVabHSdLsFt5tThju9SjPPzc2Ujc9eXYZ333oyGjL6MGIfOHuzDnazbf8A0kM9HUSPAdp3Kplha8RzWOsPDFfmZX/R4zjHMPyZ26Z6U/cW3EkukRtAl8syyIXBJRiuCCPrWiR6jJfXKQxs658zsBRF7OM7sWYj1qLn1URDHvcPU/a6aU2CmUf1T+eqHcG3EttpHs+pI8VxG599zkuCc5zv54+lFWcXUpdQeXoM1E9kAcYYqPgDRa0twvKq7jHXrTIyyVETYXbBaako20jBrc7Lq3tw3YGiEVoO4+1OQRDb+dTokxtvRUUIaF2SUqOloMD3aeS2Axt8M1KVQO29dco7nrRACGMpKaCjpmveUY64p3HlmvMGuqGZN8gI2ptotqfI+vrSIry6HWUCSPbbFRJYsDp/Si0idwCKiTR+hrivZIh9tH/mBjzpVIiT9OpFKvLz91YPh1puT8p/jXZPbauHxj+tRcgghjg+05PT1rLOJYfZ9RmXooYgfWtUc5uMAVR+JY4rm5lJI3Y7j41n6qd0Ls7d7qbap9I4SR7qkk77Yo5p3FF5aWy280cN7AjBkW4UsUIydiN+/eh1zacjbNtU+04U1W6hMogMSdml93OzH/8AOPiR50DT1E75uJGLuKGbXSSVHGIu4+ylNxVM1pFBY2UNmyEEPG7EAcoGOUkjt3z189y7w7pJuJ+aGC3tz1ZreBIh8+UCntO4T5XTmv7NnOMorBmzttt33P8AZq52VmtlaCJGHN1Y+Zo2aslzWmFh2Tf4t77tLQPL/tKJFtYRHHk46sTuarGt6Cs0jz2jiOQ7lG/KT5+lWSZuXuD8DQ+d8nBaXA3Phpnb41VSyziXiRafZDvhbKLOCB6bos1pzXFw6DI5VCtnr3qSUmRvdbNO3M4jBYeKYQdpCCQfmNq9RWbBiImLfqRqcj5nrTJrZJ355NSioYBE3suUMgPvqc0VsiO60xApwMqy+jKR++iVtGNtsUwjjDVfI8ZVNtwvbI9MVKeSKCPmlkSNfNyFH3riFBgbUB4ziiuhpiMLaZbe9SWaKVhyhSjqGYfs8zLVrjlF0A0Ne8NcbBWEXNuER/HhCuMqecYbA7edee3Wy3EkDyhHjRXcnIUBiQu/TciqjHw1Yx3mlvcz2NxFa3E8txE2FjDzD3QqHIABAwD8a81fh2HULvUUS4s1tbueyiEay8uEiPvxgDocHYD7VDM5WCOlLrGTTy/8rfbVXCa/soLWS4muYUgjyHkLjlUjqM+fp1r03dt7MlwbiIQOAVkZgoOem586p93w/ZzaHxBZW8unL7fKZbMBlCKFjQbAdCCrbjOM5rzXdCt76LR3sJbC1is0kd7RJI+Qq2AXXmQqSCDuV7ncV4vcuNipSQOJue3S/wB9FcpZoYSomlijL9AzBc9tvqPrXJurZIVka5hWJ91cuMEehqjanwpZ3Emlo15aT2dlbRowuJhzBTPGxPTGCnMo6dQO9dXOjRxtw/bAae5tYriKTxMPFFNIFIYr6kPgbeVcMjuim2GlIFpddfoD352V5lmij5DJIi85CrzHHMT0A8zTcq7b96pl3oEVtJo50qe0uLmxRbWN7yZWQMGyfc5T73qpBGw7VIl1rWbvjqGx0+3T/A41zLd8uVlPJk8rdDglRgd813idULUPhgDCx2bMbfXp0VjRf0opU6B+kHxpVYpOKmtLg4pp3znf70zMreISNh3obcazZ2zMiyBpFG5J2FDyStYLvNkI97Wi5K4169FlCwH+o4+lUI6nBHfJLdRePErZZAcZFWPU5v8AEIZAd5KpF/p1zG5OMigXU8M7OLdNaGkpaqAvmdYoqeJ7GEf5bRLbnAwGlkL/AKpXcd+v9negWo6rcajcmfUrnm5j+u/ujckAZ6dTgVDkikTPOp+dTdGDLchlCE9PfQMPoc12CIujLS3Q+QTOioGNgeWsuTsVeOCLeJ4DcoQyrspHnVkkPUn/AMU3p/P/AIbAZGBZhk7YHp9sUpMj9k+oINIaiJ7ZMhBSXKQTdR5JOYkRkM/kTj70PuboRHld15QcFGb3T6etTLqTKhWKun+3K0Oe4WH/AEIYo2wQWI5iR8TWlo3M4QaOSYUwBFvz89FwSyP4ns2nNC3vOiOd/IFSd8elexlOYeDEsQ7hCf4k1BB3qZbi3YDnicyZ/OspXbyxRLWhEujLBcaonbnPUk+tFLYZ64oTbdds0VtiNqtCCnROHf5elcyWNpO0jT2sEjOAGLxhiwHQEnr866hOR1ycU8DvXUreAd1WdWstQGoXT2Gn28qOgePmEXhl1RipcH3i3MQM9AMHzp5rLUYLxHSxsJ4fGidisKqcnm8SRQW2bdBnJ2FWMf3mu1zVXC1vdTMwsBkb7Kp6db6k0dl4ul6ZHzyFCvsxUxKVJcjcgdMdubPyM3XNPmElsuladbgRxyByY4+Rk5GCx4645ypxsNutWAn40siuiOwtdQMjc2YMHlbRVHULPUZrSeC106w8WSzceI8Kgh15hGvdTn3DjJC8pz1Fei21XnJl0ywckc/MIUy7hmCl/f2IXlIwTu2MjrVs+P22rmucLuVMTNtbht9kJ0uyDWkUl/p9pFd85lZY4wVVyeoPUnGPe6nyHSlPo1m8lpIkXgG1cvGIsKN+owPOif8Ae9cNsNqkWNcMrtVU5rXm9gmB/qClXgP6QUqmrSqXq+tXskqCFXeMb4B2NCdVtDcANDyo+cnJNKlWEqZXzEh5ukEreJma43T8LNHbJ48nvAYLDzobqF5yMcOSPWlSo+elZHQsmb+4pnPSsjoWzNvmQS8vOc9s+lStBZXuVMsaSp+y4JFKlWgpHEwsv0W/oBegYT/xWtQwn2SDkUAeGu2+21NSwSHtk+tKlU3tHEa62qyechyH3FrKckL8xQ6awuD+ofhjNKlQpaOJdTbIc10wNOuM/kP0qVDYzjGVNKlTADRMDUPsiFvayjqu1EreJwNwaVKpIGWQlEIVYdc1IC4PSlSrqAcdV0Mj510B8RSpV5VldDPxpevWlSry4vDmuSM/1pUq8uhcEHv964dT2+1KlXlIFMBDzgmlSpV5WEr/2Q==",
+			Image:        "300e0f284d569038b35a8de955598c7f4a5e0e16682d8055caebfb79b08fa94b",
 
 		},
 		{
 			Id:			  "8bQQ0lP96186D8Ibo8IoOd6o16qioiIo",
 			Name:         "アルストロメリア (walk with you remix)",
 			Artist:       "TAG",
-			Image:        "/9j/4AAQSkZJRgABAQIAHAAcAAD/2wBDAAYEBQYFBAYGBQYHBwYIChAKCgkJChQODwwQFxQYGBcUFhYaHSUfGhsjHBYWICwgIyYnKSopGR8tMC0oMCUoKSj/2wBDAQcHBwoIChMKChMoGhYaKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCj/wAARCADIAMgDASIAAhEBAxEB/8QAHAAAAQUBAQEAAAAAAAAAAAAAAwACBAUGAQcI/8QAPRAAAgEDAgQDBgQEBQMFAAAAAQIDAAQREiEFMUFRBiJhExRxgZGhMrHB8CNCUtEHM2Lh8RVygggWQ7LC/8QAGwEAAgMBAQEAAAAAAAAAAAAAAgMAAQQFBgf/xAAvEQACAgEEAgECBQQCAwAAAAABAgADEQQSITETQQUiUTJCYXGRFKHB8NHhgbHx/9oADAMBAAIRAxEAPwD34S+bHUVyRidxTXTckVwZ2rrYE8QWbowsSkHfrSlk9lz600SHlQLiQEgHnUCknmW1gRPpnGlGpqGZQRimyjVyGM1xVUcwaaAJhLsTidXVq2qbF7IW5ByZD8gKFGqHAx86IwAyM70DHPE0UqU+ruBaMjOo/Su+00Dn9a5I4/8AIUwI0jcs/Ci/eLJwcLEZzj170WIh1GT86ctoRnUNs53ogjWHdjtQll9Q1SwHL9QiQg9CcURbdFyTtXIplxgVSRXt5xG7Zi723D0dkDBPPIRt8APlmsWp1PgGWnX0mjGoBK+peqg5KtNNqzHf5UWJY1iEgd9I35nJ+VVnF7+VYSY8IB1L4IH1Nci/5sUHoH9jn/E6SfDq4BYyc0UcTYc4PYihtPboD7Rgo7kgV55xPj07RS+zn95VTuFcH6Y/e1PsouJO6H2xEZAJV98jG2R3rBb89cD0APU0V/FUjsEzeKbacaoJVccsg5oL22gkjlXnF3YXPC+ItcQ3NzGZDqCx/wCX6jFbXgfiGO9RYLhSsoGCTz+Pr8R9K6eh+bFn03EDPuYNZ8KDlqh1LLTgg4pwZv5RnvvRJ0KjNARiDuc16AcjM88w2NthXIxmmM/mGKfpHPOfjTHUofLuKgxLbPclQyFTjoaVOt0GNzSpDYzOhVu29yMx08+3WuaxjbpUa4tHcfwZXjI5b5H0/tTrdXKASfjGzY70FD252XAZ9EdGZ71T8VOcfY9iF1aqZKRt/UKIVHKk4AFagZlIJEbyBYdaEwY5IG1OWXQw2BwaI0qkk8s9KLkQPpYdxivpPLankazuDiuqRnYZqRFgnlmhJxG1pu4Jglh1YwKlwRLGCcYpssgjXIA1GozSOQdRzS+WmgFKj9zJskoAwDmo8gExweQoGo42rsKv7QbmoEwOJRu3nBHElQWragAPLQOLlYLfDSxQxjfDLn54rvEMRwLIAC42ReWpv7fvpWUlglvy7zylrdGwzHkTzOPQf2ryXy/yDnNakH/P6T13xWgrrUWEYzJVhp4hOfdRKLSLeSaR9m9AOVVkqnj87PpP/TImwmdhIQcavh2/vUmTidhDwaUMJpbSRgoihTSWB6EsRz37bH51WT+Ib/iUfufBLFLRF5EnOlR1ONh+vwzXE8SL9WPq/j/ft1Os7Bjn1JUtvEsgtYgrTMD5eiAbknucfp6Vb+Hki4hw1EuUxIqjUPwk7fiBqFwzgEvCbNp5ZXueIzAorv3YEYA5ADOcfGtNacJEfCoIGP8AEjQLqHMkCrTTuD5DzIXyMCZvxLA0dmIJNTQ6gVm/mT1OOfWoSW5glWO6wZR5o5U/m/3q1g4WVuLq3uEU6917sP8ASTyIIzjPU1neJJxB7+GAxlI1bCy52AAJB+1XYhIAz/vuLBJzmbzhN3HeW/sCQZUXO3Ud6MbbDY5CsZwa/ltOPWQkXGosjgcvw5/3r0CVlfDIdiM5Fer+F1ZsoFbHkf8Aqeb+V0QLm0DiRBb4YZNP9mADgjauSzaWwN6EH1uST8hXb5PM4mUU4ElxBdjnOaVcRlAG1KlGbEwBHuqE7bY6UIwqScdeeKUhK7ZzXYyx5/arx7gEgnBEA8ZG2M0xo8gg7Cp7Lt0pjprxkbUQeLagSu93LZwc0vYYXrtViIttulDkQgb70fkzEnSgDOJXsrggrkZqbbjSmW51wRnPeusfL1qM2eJVdew5jjh2GaZIoGcinRr1NSPYGSInONsgd+9Kaxa/xGaEqa0fSOZAOOQoiaUVpWOygsa40RBz0pl4xhsZ2A1EqVA7k7AVNRZsqZvsIGnqLWgETP8AH+LGa5S2t8thfMR0H9z/AHpXBEfDIbWIEtMPMO+eY+px8KlLw73OLGFacDUzYxqfl9Ac/as2ZZE8ZcDt3Le6skjkn+cgED8zXgHrLNtzz7/THf8Ac4nuKMsdzdeoY8Oa4iMJZjM84I0cgQcZP+9b3g/Brbh1sERAOrMebHua4pt7di0RjB6gEZqx1ZTJ5YzW2ulK+hGspB46gEe1nulbRJqjyFyjAb9alPgDArLXvjLgfDLlre7vAbgHzKAcL/t606x8XcK4ndG3s7gvMMnTobOO/KtTqwXcRxKABbAljxi1S4tmzkMoyGXYg9xWOlMYtBLM0rXlsTnDsdQOwbHUb1topPacuRrO8Q4YvvTEhDqbCAjPrn5fqK5z1hzkHEeE+rBlXwoWx4cbm4w0sy/jIzo5jHxrQ2V3kusAKRhQNLnkT1qheyiFyFkTUi7kI2kFs88bD1qbZ3ltcTzWiRFZVBddQzk9yc8jg/T1FaNK4ptDDocTQ9SGraRwZopo9gds1y3iAyWXIIOMHrQOEzXU3D4BeWbxy4w7KwKZ7g5Bx8qsFBGwr19dodMifP8AUaXx3HMZHGcg0qnQR53xSoWs5j69LxIpj770kGKlSDI5UAoc7UAszI1BU5EZ6cqQQ7b04rnlXdJHep5IvZzzHqBj1pFVauoMjlTilB5I/wAfEEYhnbnTGiwDUlR3FNdN8VfklNSMdSGI8GjorFSuTpPSnaPNTl2yBVl93cBK9h4gHhoMsI0qW3VWDEH0P7NSJjNgCIIc9WP6UFrM3G1zIWX+lfKKXc7lCqjJP8Qq6k3hjxKfikjXrtBbMEiyfaT+m/lX771g/GjBON2McbmNYIFjZkOMZ3Jz3ya9X4gYrexKJGC5GlEUczWK/wDbDcbvyJiUUt7Sdx07KK4D1Gq0UryzHJ/31PSad0ZPI/AHUBwPwhcx3Zuf+rXSrnOFJOodjqJB+lehW6/wQhOdsfGgcHt9FosbnLp5GPcjb9KZBJOt60TwuoBOG2wRnardbM/Xz6mkbXzs4lRN4K4K1xJObXMkja2Oo7nud/jRIOEW9iW90t44weZVdz8a02Bp3oDIKVdWzDBMldu2V9uhBHPas74juRBxEq8h1yKBGoPIH9cj8q0nELuOytJbiQrFDGpZpJNgBVVwGzteLOOKvGWMn4ZHXDFemAeQ/OgrTB56j0sAO8ygbhE3EL0LbyyhcaXB2VRg8zj4bc6tYeGpwewuzA5lvHjP8THUDYAds1pp9CRiOEBUXbA2AqluI5Lq6jgi5sfMeir1NZmyHFSezCNu8Fm4Al1w5y/DLcH8SKEYdiNj+VSI48nei4HIU9Fr1qNsQLnqeUsTyWFgI+MYG1KnIMUqEvNKpgSO+MZzgUAyIQMMBncZ60RiGXzdaHoJ2V2wfr9axm8nqG9MQXtRUTPSuonLPOifh2HOnKx9xQo5nVQDpTigpmo10MaLyCEa4imKG2BRGJPOhSDIowwMTYhHUHIygUEygchTnTahtGcUwGYX3Thl1dN6IjPyXAoQjOaPEuDVs3GINStnJjLkCOF5CNUmMA9vhROHQ+62iIBhz5mPcnnXb2JpbSVI/wDMKnTnv0o6kFQR1rIFCuWAnTDHZtgYY2inllJ8kpyR/ScYz8wKzvEZfEFrfS6/YT2TZMbQqVZB2Yb5+P5VqsjkeVV17NHbgx3qk2rfhlIyF9G7eh/Xmq2vcCV5m7SakVkBxmZThM/iDiHEQ3v/ALKyVvMrRLk+g2+5rYTzx28DSTOFRRksazY8U8IRXThZ96ZekK+XP/dyqM8lxxN1e6XAz5YwPKv9z61kdLQMuMTrFRedyjCyNxi8n4xNg5jskIMcZ5yNnZm9M8h8z6a5ZVtbRVUAKqgACqeztEnvFUAaYyGb5cv36VaX8WqAqa5jO4Jcy3Fe4IOhICcQa6ujbwLmT7AdzVza26W2dO8jY1OeZ/2qF4dsoYrWS4iYvJO5ZmPpsAOwGOXck9aswuDvzrq6HTCtfI3JP9pztW/lbaowBCqCB60kbz5/KgzTBAFGd6FHKDyNantAaITTnGZZo21KobXcUMeqR1VR1NKp5ZCm3uMOwNEj5Zpoxvn702QvGFMUYcv5Rl8b4J7elIUTQVzxJBYY70lzQ5ZEiCF8DOMknAA1AE5+dK6l93t/aKgfPIM2n9P+KaSYvxk9e4Y10UyZvZxe0UaxlRhd8gkDb60pZkhjR2DYc4GcL0J3zjHKg5zA2E9R7A0Mgk8q6lwps1uXV1QoH08236fGksjG1ikMXnfRlFOcaiB26Zz8qcrSeM+/2jCveuaRvyrgYvaCfSpyuo+bAx3zvSjd2j1SxhCdwA2dvWmB4ttKDOhQOVOwKiT3DRnTGhaQ8hTbWW4b/PSNc/0MT+lB5gTtgDSCT1ruMDYAfCoElwzXaW8baQfxOOYPYZ27fWsXxLjvFeG8XtzG5ktmcxSxybjVnnnmNvyptdTXZ2wvCFnoDGkZFSJ3kOEUEknoKix3AmijkUHS4DD5iq/jVxJJGbS2I1kBpD/Svb5/vnWO/UChDYfUemmyRK3h1lFdxe+x2y28skjsV64LHn61LuvY2sQknIVWIC45sfSmW8zRNMjAGAN5Rvlj1Hwz19cU60t3vuIia63WI5A6A9BWKzVlwEJ5M3lig2j1Liyt1hhGFAZvM2KBxXUtpK0f41UkfHG1T+m1VPHLoRwMgI1Y/wCP36Gk3bQvMWhJOZTeEeMhJEtLjymQYAb+VwOX6fId608/tCcqTgb15l7eKG+iS4jEizIHVgckEbH020iry1juoyXto293HNlA/f8AzV1avwV+Nx0eIkfQ00rpKWGokk/amRpIrnTyAzuKonvLiNgweSOQHcFiPsdvTlWg4Hepfowb/OTBPqDyP1BHy9aetyO+z800C8FeoW0s45HSS4zLKh21cl+VKp6qFbzbfOlWgAiIIA6hDGD6GmSOIkLFCxUbAc9zjb7fWiIw5HNdkZ1kiVCmHONxvyJ7+laEUN1Fl9vcjvcx/hKKT5WAbtkYP3BonvK+7LchC2dsKMnnjH1xTvbH2kqhSQqllwfx42I+v50+CX2isdSkqcEry5A/rRMhAyYPlQ4AEjy3MSRMFjTSi6kBOBsARgfvlRhLsjAMdbaduh3/ALURmYdaCXOd9yORrDbqFUjIkLKfUal3G7xAqwZz5dQGxx+felJeIjMrI5/Hvkb6efX1oiuepogcjrRV6hWkDL9pGe8iCOrR5VdSldt8EDYdt/tRXIfJGCM7Eddgf1p5du9MZs860eQGFkehIxTSSwwM0FiysB96lMc5qM25PWq3xyQEiiO7jfSNTNqJ77AfpWf8X2IdpigA14dT/qxV/enQsTtnCtk79OX6/aonGMT2jN1QH7Vt09m0giIdQCRK+HjhThdusMYMrDctnSvYevMf3p/D4pILIyXEntribOqbnqzvgeg374+VVIaCLhz6wrEEkDsf7YAq/wDD0bSRrLcgswGAMbD9K83qjY970v8AhB4mpHUDicAE8QLRmQjdiV22+P7+9W1gixwDChSdyB3qJxEpCg0KU1Np0555p/vQRRjcnkBWZBhy57hhNwyJMuJ/ZodK6mJwo7msnx64/gsw8zgkAHqcbn4Y5Vd3RfB5lyPNj+VcZIHqcVmPEkumcSjaOJSuB3wcbVVz74vcA20SrjtlurFnUkPC2SOuk8/yB+tavgK3XDhCsza7ObAXJyUY8vlWa4VCLezZ5CSJN/TBHL71L8IcRupbefhXEGJlGQjNuUbpv26iiszk8weSMjqWnEzD79NEpQ43ABHlPb6/mKjeG5Xh47EiHKszIw9NJP5qtM4lba41lDhJY1HlLZKnrn99KL4Mh944mZtBURgnf5j9ay1WFtcmzqLTkEGbX8R5Y3pUVVwxx86VenIhFoD1ztRFnYdgKABjlSYjHasouI6lHmTUm17U85POoUT757VJEgPenrbvHMUwjmyKCRmjZyMimNtWW+vPPqLIgx0pxbemkVzrWUbhxJA3kzW+JgupRs4HPHenrKsiBlIIPWnuMioc1nFIug5C9hTxYwhLkRyXKSyvHEdWjZiOQPauNkHbnToYY4IxHCgVOgFGCAKWfZVGST0rVSWYZaaEbHcp/EDOtgpCk6TnA6k+UD7/AGqnhuFuIG21NgbDvjkKuONe1uLQrASrOfLkch6/nULg3DvdpNbMdZyM6c4Geg/Wq0/ySkOF9nj/AJhHTlzuPUqm4BdOYpclkBJZAw2O2Dv8KuvffdbfQh0EDBzzT5dam3KQJMF0PqJyXI5VjuIyzXHiNLNmjZD5y6AghB0O+M8/tXO1W61yxPJ7jhUB9Qlxxm7ZrZJ5OSOCMdd6n2ayRIryAm5dchDv7Ne5Hf0pkEQ1rOVDohxGnLU3f4Cp0ceAXkbLE6i42IP9qN0VVAB5AibLzjYs7CDHES2GJ8wOc56/3FZTjtn7cR+YqIiWO/Pbn6bH4Vrb0qsDq7BT+IsOWe4rM8TuU9zlmXeQKV0g9e2Ou/76Vhwd6IsWTjkQckI9iuduWQdgf33pCONeJGdV0TxyISe6nbf4Y+9BgYzWRkJGP5Qd9OefyooOma7kOdLAIAee39ifpU1CkWcHqWj7VkHxRdNDxN4MYilGWIO45fv5VrPAdtosZbknysdKjHLHPf47f+NZWeBeI8WcOrNIAAB05jH3Ir0q0t1tLOKCPcIoXPU+prV8ZSrWG0+omsk5MKxydhSroGN6VdgtGZxIbg5yaYTqGDkDvRmAGwxTGj1bggVjNcMRpkbRoXGB16mnI+AKE50gD60uuMbd6Z9R79SeMSVE+G57daOCGqIMgDaiKe1GDt4imWFYY5UwKScgZFFj8w9acwxR+ENzAgGGB0FAaWNW7064Yk4HSorDme9IsODhY1VzJCTqx/CaFfzPIY7eBc6iGkcnZV/Ukjl8fm2NfNtSgOqaVm2DOfkBt+maqxiKirfm4jAmDkTpjAULvnqTUQI8c/lYKejMMijcX4nY8LtTLdzKi9BnJY9gOteaT+JrvxHxa5tEL2nD4jjC5DSDAO5/QfU1NJ8e9zBE4xGNeKwczXcRPFBxBXivbeSMY1RhAuN++SapOB219e8c4tPcReyf2WiPO+CdgftVHf8ACUlwLYNFpAyyOVOCOfP0raeEbS5sOEkXrk3UukE5yQMbfrTPlNH/AEuG3ZyPtiLS7cNmJLuLh4XSKCGRlUaVYDOe9S1SeRSwYKT0zyojCJdPsyzADcqM/Cn2soJIZSCV5N2+FYbUZU4EUN27kSBcxSm2ZLrSNJ2VDnYcx9Ko7nhomfyXBQDd9QO/TY8huDzBq84jOVK91ON+45fUVTmfROpBBXGGyen/ABj70hOHDQXck4EHZ+yiuHhjOpeWOeevz602eRW1jG/PAYfvuKioFhuZXt8aCx5Ny6kn+9SI7a5a1uL2NWeCJC0mMZOkZwB1NKuGSRWMkwmVR2ZP8GRS3XGppW2ht0Gf9TnONvhn7VuicjeqHwVw+Sx4P7W6TRd3jm4mXOdJIAC/JQo+VXxFdfTU/wBNUE9+/wB4IOeYs9qVIDHOlTu5WYIgZO2BmhsAM4qSyd6YY81CsaGkR8sMAV0LsNsijFMHFOC70QWFvgGcIBq3Y8lHM0eOM7Fj8qAtuE4lJcsTholRR2wST9cj6VKD9hVlQpyTEklu44tpOBzpkkh60sZ3rtKLsf2lAQLDl96CUw47dqkn1oenJ5VQ5MYnEGdMKPI5wFGTUDhsxu+ErOmVZs6geYIJBB+YNSr2ZFVkJyVAJ+PT9+tZ/wALXubG6jH/AMdzIPqdR+7GleRbXZB+Tv8Ac/8Ayaa1aYvxtF7Lj8EtzK7LIoCxk7DGTsPlUYOsEqSQ5yzKxA5nOQftpoX+L0ivLYsoDOlwgUE9cNn7fnVPwd5jxK4GWYeyOBjYMpB/IGuz8Xp2YJqAeBxj+0K6nIbP7z0Lglus10skmGiRNJU8ic7flWlTUJdAdB5d8HJBHMD61F4BCsHDVklUBnJION8GjwyAOFZAJCSAW2z6Vy/lLfLcD6Bg1rxOEN74kVtrAPmdug3qRcwykeZwBnmvPbrUKOdTfI5kKBVYsM/nRZJ3lg1EhR3obXXZtaMtRsDErPETGO1MiyE5IyW/qHL68qzUfExMV0MQ4HmB6nvV9xeCQ2colZXVwDkciNqwN7KlqztDmYqM+U7jFc6xQQAIk0HuW8t17C6jjt2BZhqYdxg8vtVhwbj1/Ddx8LsEi9rcPjWRkoSNiPgP3zrCDiq2P8e6bVNNgxoNyo7kem/xNbH/AAVlh4r4gvrshibeFQgdQMFjuRv6Y+dbdP8AHWsvnxx1mH4kRC7cmey7gAelJAWBxjbuaR50gMA45VsyN31TAT9p0E9aVIClQSo8iuacdafgZIBBroFaymDiWDAlBSOF50UjGwoRTfeqIx1LBgj5jvSAountS0Uhkz3LzGDlTgDXdNO00ASDBlc0lTei4odw4ht5ZG2CKWOfQUapg5lgzMXDmS4uMdZWHxxt9sVjvDfE0jbigLAYuHf6mtdEr21lHJNnWwUebmOpJ9TuT8a8u4Hw+a44pNMzFLCMD2xzszAkgfff0x3rH8XSvjvsPs5/vNotw2T64ld/iFdi441wq2c6VIe5kPzAH2zV7w9IoeFi6RNReQYPUqx0/wD6zWL8YQNxPxPayu8kcbqzFVAyBldt/QfetJDxNW4nwjhXD4JVhaZA2t9RKr5j+QHzr0mkyPj1ZBxyTHhyQVaeszqI7WOFRsFCgZxnAqHbxyvokuVIdRtqwTnvtUuVw8WJcZ06hntUN2mjiOonGnUunc7Dl8a8lrCe4VfWI23NqSGMOhixV9RyMnNTLjQQiQgAAZYchiqT3d3ilkkmbSx3wMgfIculMvppbeOGSzlMwxvk5+nrU8itWeeYdqbmCg8y/htYJrcibLllKkMc14/4t4bLwDid9JK2YAokhONjnYD4Zr0WC/nW0Ro1Us740ZI+J5VUeMuEPxbhUjPKTIi6gOgxufyH0rRpXUOu/wDCTAStxkEzxqKyuLmBbnLyGMgSu2MAdPt+9q9f/wDT/bOlxxqVh5FWKIH185P2K1h+KcTtrPg5tLSEPrAGer/vNew/4K2SWngxNiJ3mdpQ34tWw3+QBHoRXrdbqF8ZpH8fpFavCJt+83WK6BTsV0CuEEnMjMUqfilU2SToXAwAAK7inYpYrTtlRmK5pp+KWKm2XGaa5iiYpYoSkkZppYrrLkg5xikSqjJIwOpqeMSszmKrOLzxs6WerLN53Uf0jv8AE/UA1X8Y8RBQYuGkO3Iy81Hw7/Hl8ap+HyC29tPIXeWZtTysxY+gz2+3wrlazW1jdUvfs+hKDDOByYfxXI68N8jadn5f9jfrWUiWPhnha2tZTmW5zO564I5H5aR8q0fGw13HCmVKS+Xy9up+maxXi7icEd15SpwAkajsM8h2yaVpg1lAqr/Mf+v+ZuoUhTv+/uZzT7fics7ptGgRMj5n/wC32rngsteePoWDAmMlFB3A2JP5AfKjXd17vwy4u5QF0rkJ/Ue3xJNUfgO+/wCncd4dPcyBfaXGkkjqx0j869oaDVpDUPyr/ibFYcn9J7tj2Vwyso2GkgNsM9qdqTHsXVQgGQwbO/X86l8RibKSRMF331DIHr++9RbizhuVYx6TivEX0hwRniUrq+CTI9xDGYzArD2hYucciSeR+tQ04fIoJlCqCfw52+P50SLh/s7rLlwuOfMfvlTeI+cKq7RxrgjOCB3+1ZLazgFTz7j+jtBiUw3S+whZowmRq778x/f1p8tkk9s8TyOzkYO+NulQCVSIsB8hXbd2jZmkZY4z1Y8zWO/U9BeMSvGR7nlcXAWt/EV7FICwgfEbPuWB3Gfl+9q9e/wmu2DXtk52IWZR6/hb8lrL8auY7XiourkRxwvGUDnYDGDk/LND8CcbL+NYLpVaOzuJDapkY15XOr6hfkAa9Sb11S1as8N+E/r/AL3KvCHT7PZyf/I/6nuWKQFKQOV/hkBvXkahtJetqT3dUPIOHBFam+mcKSo3WQEqc4JB+IpUywtvdbZYyxZtyzHqSck/WlVoCVBYYMkkUqVKnyRUqVKpiSAvLy3s4jJdTRxIOrsBVOePm6Kjh8WQ3J5QRn5c/rilSrJdayuFHuKtcpjHuBvILyWFmkvp1kIJX2WAB8Mc/wA6qp+HS3FsY7iWaY53DOzr9N/vSpVzrSSScx20YxINrZzyXGq6IjiQ5XG2o/E/81ZzrF7LRIq+z56D1PQ4A36b0qVeaLln5miilazhZlPG3iWz8P8ACZDI4UhNOlWyR/pHr+XpWL8HcOn45DNxriyspY/wYwdlHb1+PxpUq9/8VQlVPkXvOI2k+W3DepVeMmkkngsIxtn2jqOw5fIn8qyXFGmhuLWKLKyLKrAgf0b/AJkUqVeiTlOZrZR4yZ9LcA4rJxS3s7tCBBNGp5jG4z9c7VdlYpZwunTIuytjf5H99aVKvnrEq7L+pEQ46P6QF1EURndy6Lk405PLHTn8Krb+ARxhoFADYJPI4pUqSVAOIVDHMistxK+iREAUgeZedAntmEy6nZ2A2XkopUq4l4BJ/Saw3qUvjHgh4z4cngjZFmA1KWOASOh9DyqhgvHsraxivLdoL6wdZ9OPxFGB1A9QRz+JpUqdoGLOqHoHI/iAVCYIn0LE2qNWG4IzTqVKvdjkZnDipUqVFJP/2Q==",
+			Image:        "b2601456427c9799a78db218db8d4e13e0578c2a5b4d4fb2470f984061b93968",
 		},
 	}
 
-	songData, err := SongDataForClient(c, songIds)
+	cache := NewJacketCache(c, util.NewMemoryBlobStore())
+	songData, err := SongDataForClient(cache, songIds)
 	if err != nil {
 		t.Fatal("error loading song data for client")
 	}
@@ -208,6 +211,46 @@ func TestSongDataForClient(t *testing.T) {
 		if !found {
 			t.Errorf("song data for song %s did not match any expected: got %+#v", data.Id, data)
 		}
+		jacket, err := cache.JacketForHash(data.Image)
+		if err != nil || jacket.Hash != data.Image || len(jacket.Data) == 0 {
+			t.Errorf("expected jacket %s for song %s to be in the store", data.Image, data.Id)
+		}
+	}
+}
+
+func TestSongDataForClientUsesStoredJackets(t *testing.T) {
+	// Setup test
+	const songId = "1PoOQPd0D01Q9O0doiQQQ8D8Q096bDq9"
+	const jacketUri = "https://p.eagate.573.jp/game/ddr/ddra20/p/images/binary_jk.html?img=1PoOQPd0D01Q9O0doiQQQ8D8Q096bDq9&kind=1"
+	const musicDetailUri = "https://p.eagate.573.jp/game/ddr/ddra20/p/playdata/music_detail.html?index=1PoOQPd0D01Q9O0doiQQQ8D8Q096bDq9"
+	uriMapping := map[string]string{
+		musicDetailUri: "./test_data/music_detail/1PoOQPd0D01Q9O0doiQQQ8D8Q096bDq9.html",
+		jacketUri:      "./test_data/jacket/1PoOQPd0D01Q9O0doiQQQ8D8Q096bDq9.jpg",
+	}
+
+	c, s := testServerAndClient(uriMapping)
+	defer s.Close()
+	store := util.NewMemoryBlobStore()
+
+	// Run Test
+	songs, err := SongDataForClient(NewJacketCache(c, store), []string{songId})
+	if err != nil {
+		t.Fatalf("error loading song data: %s", err.Error())
+	}
+	if len(songs) != 1 || songs[0].Image == "" {
+		t.Fatalf("expected one song with a jacket, got %+#v", songs)
+	}
+
+	// The jacket should now come from the store.
+	delete(uriMapping, jacketUri)
+	offline, s2 := testServerAndClient(uriMapping)
+	defer s2.Close()
+	cached, err := SongDataForClient(NewJacketCache(offline, store), []string{songId})
+	if err != nil {
+		t.Fatalf("error loading song data: %s", err.Error())
+	}
+	if len(cached) != 1 || cached[0].Image != songs[0].Image {
+		t.Errorf("expected the stored jacket to be used, got %+#v", cached)
 	}
 }
 
@@ -0,0 +1,106 @@
+package ddr
+
+import (
+	"github.com/chris-sg/eagate_models/ddr_models"
+)
+
+// MaxChartLevel is the highest foot level a chart can have.
+const MaxChartLevel = 19
+
+// LevelSummary is the clear table row for a single mode and foot level.
+// Each chart is counted once under its best lamp, so the lamp counts
+// along with Unplayed add up to Total. DanceLevels only counts played
+// charts.
+type LevelSummary struct {
+	Mode  string
+	Level int
+	Total int
+
+	MarvelousFullCombo int
+	PerfectFullCombo   int
+	GreatFullCombo     int
+	FullCombo          int
+	Life4FullCombo     int
+	Clear              int
+	Failed             int
+	Unplayed           int
+
+	DanceLevels map[DanceLevel]int
+}
+
+// Played returns the number of charts with at least one play.
+func (summary LevelSummary) Played() int {
+	return summary.Total - summary.Unplayed
+}
+
+// LevelSummaries will build the clear table for every mode and for foot
+// levels 1 to MaxChartLevel, single before double. Charts without
+// statistics are counted as unplayed.
+func LevelSummaries(difficulties []ddr_models.SongDifficulty, statistics []ddr_models.SongStatistics) (summaries []LevelSummary) {
+	statisticsByChart := make(map[string]ddr_models.SongStatistics)
+	for _, stats := range statistics {
+		statisticsByChart[chartKey(stats.SongId, stats.Mode, stats.Difficulty)] = stats
+	}
+
+	modes := []string{ddr_models.Single.String(), ddr_models.Double.String()}
+	index := make(map[levelKey]int)
+	for _, mode := range modes {
+		for level := 1; level <= MaxChartLevel; level++ {
+			index[levelKey{mode, level}] = len(summaries)
+			summaries = append(summaries, LevelSummary{
+				Mode:        mode,
+				Level:       level,
+				DanceLevels: make(map[DanceLevel]int),
+			})
+		}
+	}
+
+	for _, difficulty := range difficulties {
+		i, ok := index[levelKey{difficulty.Mode, int(difficulty.DifficultyValue)}]
+		if !ok {
+			continue
+		}
+		summary := &summaries[i]
+		summary.Total++
+
+		stats, played := statisticsByChart[chartKey(difficulty.SongId, difficulty.Mode, difficulty.Difficulty)]
+		if !played || stats.PlayCount == 0 && stats.BestScore == 0 {
+			summary.Unplayed++
+			continue
+		}
+
+		level := StringToDanceLevel(stats.Rank)
+		if level != NoDanceLevel {
+			summary.DanceLevels[level]++
+		}
+
+		switch StringToFullComboType(stats.Lamp) {
+		case FullComboMarvelous:
+			summary.MarvelousFullCombo++
+		case FullComboPerfect:
+			summary.PerfectFullCombo++
+		case FullComboGreat:
+			summary.GreatFullCombo++
+		case FullComboGood:
+			summary.FullCombo++
+		case FullComboLife4:
+			summary.Life4FullCombo++
+		default:
+			if level == DanceLevelE || (stats.ClearCount == 0 && stats.PlayCount > 0) {
+				summary.Failed++
+			} else {
+				summary.Clear++
+			}
+		}
+	}
+	return
+}
+
+func chartKey(songId string, mode string, difficulty string) string {
+	return songId + "|" + mode + "|" + difficulty
+}
+
+type levelKey struct {
+	mode  string
+	level int
+}
@@ -0,0 +1,49 @@
+package ddr
+
+import (
+	"github.com/chris-sg/eagate_models/ddr_models"
+	"testing"
+)
+
+func TestLevelSummaries(t *testing.T) {
+	// Setup test
+	difficulties := []ddr_models.SongDifficulty{
+		{SongId: "a", Mode: "SINGLE", Difficulty: "EXPERT", DifficultyValue: 14},
+		{SongId: "b", Mode: "SINGLE", Difficulty: "EXPERT", DifficultyValue: 14},
+		{SongId: "c", Mode: "SINGLE", Difficulty: "EXPERT", DifficultyValue: 14},
+		{SongId: "d", Mode: "SINGLE", Difficulty: "EXPERT", DifficultyValue: 14},
+		{SongId: "a", Mode: "DOUBLE", Difficulty: "CHALLENGE", DifficultyValue: 19},
+		{SongId: "a", Mode: "SINGLE", Difficulty: "BEGINNER", DifficultyValue: 0},
+	}
+	statistics := []ddr_models.SongStatistics{
+		{SongId: "a", Mode: "SINGLE", Difficulty: "EXPERT", BestScore: 999000, Lamp: "パーフェクトフルコンボ", Rank: "AAA", PlayCount: 3, ClearCount: 3},
+		{SongId: "b", Mode: "SINGLE", Difficulty: "EXPERT", BestScore: 912340, Lamp: "---", Rank: "AA", PlayCount: 2, ClearCount: 1},
+		{SongId: "c", Mode: "SINGLE", Difficulty: "EXPERT", BestScore: 512340, Lamp: "---", Rank: "E", PlayCount: 1, ClearCount: 0},
+		{SongId: "a", Mode: "DOUBLE", Difficulty: "CHALLENGE", BestScore: 700000, Lamp: "---", Rank: "B", PlayCount: 1, ClearCount: 1},
+	}
+
+	// Run Test
+	summaries := LevelSummaries(difficulties, statistics)
+	if len(summaries) != 2*MaxChartLevel {
+		t.Fatalf("expected %d summaries, got %d", 2*MaxChartLevel, len(summaries))
+	}
+
+	single14 := summaries[13]
+	if single14.Mode != "SINGLE" || single14.Level != 14 {
+		t.Fatalf("unexpected summary order %+#v", single14)
+	}
+	if single14.Total != 4 || single14.Played() != 3 || single14.Unplayed != 1 {
+		t.Errorf("unexpected chart counts %+#v", single14)
+	}
+	if single14.PerfectFullCombo != 1 || single14.Clear != 1 || single14.Failed != 1 {
+		t.Errorf("unexpected lamp counts %+#v", single14)
+	}
+	if single14.DanceLevels[DanceLevelAAA] != 1 || single14.DanceLevels[DanceLevelAA] != 1 || single14.DanceLevels[DanceLevelE] != 1 {
+		t.Errorf("unexpected dance level counts %+#v", single14.DanceLevels)
+	}
+
+	double19 := summaries[2*MaxChartLevel-1]
+	if double19.Mode != "DOUBLE" || double19.Total != 1 || double19.Clear != 1 || double19.DanceLevels[DanceLevelB] != 1 {
+		t.Errorf("unexpected double summary %+#v", double19)
+	}
+}
@@ -33,43 +33,39 @@ func PlayerInformationForClient(client util.EaClient) (playerDetails ddr_models.
 }
 
 func playerInformationFromPlayerDocument(document *goquery.Document) (playerDetails ddr_models.PlayerDetails, err error) {
-	status := document.Find("table#status").First()
-	if status == nil {
-		err = fmt.Errorf("cannot find status table")
-		return
-	}
-	statusDetails, err := util.TableThTd(status)
+	profile, err := playerProfileFromDocument(document)
 	if err != nil {
 		return
 	}
-	playerDetails.Name = statusDetails["ダンサーネーム"]
-	code, err := strconv.ParseInt(statusDetails["DDR-CODE"], 10, 32)
-	if err != nil {
-		return
+	playerDetails = profile.PlayerDetails
+	if _, found := util.Find(profile.MissingFields, "Code"); found {
+		err = fmt.Errorf("cannot parse DDR-CODE")
 	}
-	playerDetails.Code = int(code)
-	playerDetails.Prefecture = statusDetails["所属都道府県"]
-	playerDetails.SingleRank = statusDetails["段位(SINGLE)"]
-	playerDetails.DoubleRank = statusDetails["段位(DOUBLE)"]
-	playerDetails.Affiliation = statusDetails["所属クラス"]
 
 	return
 }
 
+// playcountFromPlayerDocument will parse every playcount field it can
+// find, returning an error naming any that were missing once the rest
+// have been filled.
 func playcountFromPlayerDocument(document *goquery.Document) (playcount ddr_models.Playcount, err error) {
-	status := document.Find("table#status").First()
-	if status == nil {
-		err = fmt.Errorf("cannot find status table")
+	playcount, missing, err := playcountFieldsFromPlayerDocument(document)
+	if err != nil {
 		return
 	}
-	single := document.Find("div#single table.small_table").First()
-	if single == nil {
-		err = fmt.Errorf("cannot find single table")
-		return
+	if len(missing) > 0 {
+		err = fmt.Errorf("failed to parse %s", strings.Join(missing, ", "))
 	}
-	double := document.Find("div#double table.small_table").First()
-	if double == nil {
-		err = fmt.Errorf("cannot find double table")
+	return
+}
+
+// playcountFieldsFromPlayerDocument will parse every playcount field it
+// can find, listing those that were missing. An error is only returned if
+// the status table itself is missing.
+func playcountFieldsFromPlayerDocument(document *goquery.Document) (playcount ddr_models.Playcount, missing []string, err error) {
+	status := document.Find("table#status").First()
+	if status.Length() == 0 {
+		err = fmt.Errorf("cannot find status table")
 		return
 	}
 
@@ -85,48 +81,31 @@ func playcountFromPlayerDocument(document *goquery.Document) (playcount ddr_mode
 	if err != nil {
 		return
 	}
-	singleDetails, err := util.TableThTd(single)
-	if err != nil {
-		return
-	}
-	doubleDetails, err := util.TableThTd(double)
-	if err != nil {
-		return
-	}
+	singleDetails, _ := util.TableThTd(document.Find("div#single table.small_table").First())
+	doubleDetails, _ := util.TableThTd(document.Find("div#double table.small_table").First())
 
-	code, err := strconv.ParseInt(statusDetails["DDR-CODE"], 10, 32)
-	if err != nil {
-		return
-	}
-	playcount.PlayerCode = int(code)
-
-	playcount.Playcount, err = strconv.Atoi(numericalStripper.ReplaceAllString(statusDetails["総プレー回数"], ""))
-	if err != nil {
-		return
-	}
-	playcount.LastPlayDate, err = time.ParseInLocation(timeFormat, statusDetails["最終プレー日時"], timeLocation)
-	if err != nil {
-		return
-	}
-
-	playcount.SinglePlaycount, err = strconv.Atoi(numericalStripper.ReplaceAllString(singleDetails["プレー回数"], ""))
-	if err != nil {
-		return
-	}
-	playcount.SingleLastPlayDate, err = time.ParseInLocation(timeFormat, singleDetails["最終プレー日時"], timeLocation)
-	if err != nil {
-		return
-	}
-
-	playcount.DoublePlaycount, err = strconv.Atoi(numericalStripper.ReplaceAllString(doubleDetails["プレー回数"], ""))
-	if err != nil {
-		return
+	parseCount := func(details map[string]string, tag string, name string) int {
+		v, err := strconv.Atoi(numericalStripper.ReplaceAllString(details[tag], ""))
+		if err != nil {
+			missing = append(missing, name)
+		}
+		return v
 	}
-	playcount.DoubleLastPlayDate, err = time.ParseInLocation(timeFormat, doubleDetails["最終プレー日時"], timeLocation)
-	if err != nil {
-		return
+	parseDate := func(details map[string]string, tag string, name string) time.Time {
+		t, err := time.ParseInLocation(timeFormat, details[tag], timeLocation)
+		if err != nil {
+			missing = append(missing, name)
+		}
+		return t
 	}
 
+	playcount.PlayerCode = parseCount(statusDetails, "DDR-CODE", "PlayerCode")
+	playcount.Playcount = parseCount(statusDetails, "総プレー回数", "Playcount")
+	playcount.LastPlayDate = parseDate(statusDetails, "最終プレー日時", "LastPlayDate")
+	playcount.SinglePlaycount = parseCount(singleDetails, "プレー回数", "SinglePlaycount")
+	playcount.SingleLastPlayDate = parseDate(singleDetails, "最終プレー日時", "SingleLastPlayDate")
+	playcount.DoublePlaycount = parseCount(doubleDetails, "プレー回数", "DoublePlaycount")
+	playcount.DoubleLastPlayDate = parseDate(doubleDetails, "最終プレー日時", "DoubleLastPlayDate")
 	return
 }
 
@@ -231,58 +210,68 @@ func RecentScoresForClient(client util.EaClient, playerCode int) (scores []ddr_m
 
 // TODO: error handling
 func recentScoresFromDocument(document *goquery.Document, playerCode int) (scores []ddr_models.Score, err error) {
-	timeFormat := "2006-01-02 15:04:05"
 	timeLocation, err := time.LoadLocation("Asia/Tokyo")
 	if err != nil {
 		return
 	}
 
 	document.Find("table#data_tbl tbody tr").Each(func(i int, s *goquery.Selection) {
-		if s.Find("td").Length() == 0 {
+		score, ok := recentScoreFromRow(s, playerCode, timeLocation)
+		if !ok {
 			return
 		}
+		scores = append(scores, score)
+	})
 
-		score := ddr_models.Score{}
+	return
+}
 
-		info := s.Find("a.music_info.cboxelement").First()
-		href, exists := info.Attr("href")
-		if !exists {
-			return
-		}
-		difficulty, err := strconv.Atoi(href[len(href)-1:])
-		if err != nil {
-			glog.Errorf("strconv failed: %s\n", err.Error())
-			return
-		}
+// recentScoreFromRow will parse a single row of the recent scores table.
+// ok is false for rows that do not describe a play.
+func recentScoreFromRow(s *goquery.Selection, playerCode int, timeLocation *time.Location) (score ddr_models.Score, ok bool) {
+	timeFormat := "2006-01-02 15:04:05"
 
-		score.Mode = ddr_models.Mode(difficulty / 5).String()
-		if ddr_models.StringToMode(score.Mode) == ddr_models.Double {
-			difficulty++
-		}
-		score.Difficulty = ddr_models.Difficulty(difficulty % 5).String()
-		score.SongId = href[strings.Index(href, "=")+1 : strings.Index(href, "&")]
+	if s.Find("td").Length() == 0 {
+		return
+	}
 
-		score.Score, _ = strconv.Atoi(s.Find("td.score").First().Text())
+	info := s.Find("a.music_info.cboxelement").First()
+	href, exists := info.Attr("href")
+	if !exists {
+		return
+	}
+	difficulty, err := strconv.Atoi(href[len(href)-1:])
+	if err != nil {
+		glog.Errorf("strconv failed: %s\n", err.Error())
+		return
+	}
 
-		timeSelection := s.Find("td.date").First()
-		t, err := time.ParseInLocation(timeFormat, timeSelection.Text(), timeLocation)
-		if err != nil {
-			return
-		}
-		score.TimePlayed = t
+	score.Mode = ddr_models.Mode(difficulty / 5).String()
+	if ddr_models.StringToMode(score.Mode) == ddr_models.Double {
+		difficulty++
+	}
+	score.Difficulty = ddr_models.Difficulty(difficulty % 5).String()
+	score.SongId = href[strings.Index(href, "=")+1 : strings.Index(href, "&")]
 
-		rankSelection := s.Find("td.rank").First()
-		imgSelection := rankSelection.Find("img").First()
-		path, exists := imgSelection.Attr("src")
-		if exists {
-			score.ClearStatus = !strings.Contains(path, "rank_s_e")
-		}
+	score.Score, _ = strconv.Atoi(s.Find("td.score").First().Text())
 
-		score.PlayerCode = playerCode
+	timeSelection := s.Find("td.date").First()
+	t, err := time.ParseInLocation(timeFormat, timeSelection.Text(), timeLocation)
+	if err != nil {
+		return
+	}
+	score.TimePlayed = t
 
-		scores = append(scores, score)
-	})
+	rankSelection := s.Find("td.rank").First()
+	imgSelection := rankSelection.Find("img").First()
+	path, exists := imgSelection.Attr("src")
+	if exists {
+		score.ClearStatus = ClearTypeFromImage(path) != ClearFailed
+	}
+
+	score.PlayerCode = playerCode
 
+	ok = true
 	return
 }
 
@@ -296,53 +285,9 @@ func WorkoutDataForClient(client util.EaClient, playerCode int) (workoutData []d
 }
 
 func workoutDataFromDocument(document *goquery.Document, playerCode int) (workoutData []ddr_models.WorkoutData, err error) {
-	format := "2006-01-02"
-	loc, err := time.LoadLocation("Asia/Tokyo")
-
-	table := document.Find("table#work_out_left")
-	if table.Length() == 0 {
-		err = fmt.Errorf("could not find work_out_left")
-		return
-	}
-
-	tableBody := table.First().Find("tbody").First()
-	if tableBody == nil {
-		err = fmt.Errorf("could not find table body")
-		return
+	days, err := workoutDaysFromDocument(document, playerCode)
+	for _, day := range days {
+		workoutData = append(workoutData, day.WorkoutData)
 	}
-
-	tableBody.Find("tr").Each(func(i int, s *goquery.Selection) {
-		if s.Find("td").Length() == 5 {
-			wd := ddr_models.WorkoutData{}
-			s.Find("td").Each(func(i int, dataSelection *goquery.Selection) {
-				if i == 1 {
-					t, err := time.ParseInLocation(format, dataSelection.Text(), loc)
-					if err == nil {
-						wd.Date = t
-					}
-				} else if i == 2 {
-					numerical, err := regexp.Compile("[^0-9]+")
-					if err != nil {
-						panic(err)
-					}
-					numericStr := numerical.ReplaceAllString(dataSelection.Text(), "")
-					wd.PlayCount, _ = strconv.Atoi(numericStr)
-				} else if i == 3 {
-					numerical, err := regexp.Compile("[^0-9.]+")
-					if err != nil {
-						glog.Errorf("regex failure! %s\n", err.Error())
-						panic(err)
-					}
-					numericStr := numerical.ReplaceAllString(dataSelection.Text(), "")
-					kcalFloat, err := strconv.ParseFloat(numericStr, 32)
-					if err == nil {
-						wd.Kcal = float32(kcalFloat)
-					}
-				}
-			})
-			wd.PlayerCode = playerCode
-			workoutData = append(workoutData, wd)
-		}
-	})
 	return
 }
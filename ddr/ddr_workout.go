@@ -0,0 +1,233 @@
+package ddr
+
+import (
+	"fmt"
+	"github.com/PuerkitoBio/goquery"
+	"github.com/chris-sg/eagate/util"
+	"github.com/chris-sg/eagate_models/ddr_models"
+	"github.com/golang/glog"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// WorkoutDay extends ddr_models.WorkoutData with the weight that was set
+// when the day was recorded.
+type WorkoutDay struct {
+	ddr_models.WorkoutData
+
+	Weight float32
+}
+
+// WorkoutSettings holds the totals and settings shown above the workout
+// history.
+type WorkoutSettings struct {
+	TotalKcal      float32
+	TodayPlayCount int
+	TodayKcal      float32
+	// Weight is the currently set weight in kg, taken from the most
+	// recent workout day if the page does not show it separately.
+	Weight float32
+	// WeightDisplay is the weight display setting as shown on the page.
+	WeightDisplay string
+}
+
+// WorkoutSummary is the workout data aggregated over a period, starting
+// at Start and ending before End.
+type WorkoutSummary struct {
+	Start      time.Time
+	End        time.Time
+	ActiveDays int
+	PlayCount  int
+	Kcal       float32
+}
+
+// WorkoutHistoryForClient will load the workout history for a client,
+// along with the workout settings shown on the same page. Days are
+// returned newest first.
+func WorkoutHistoryForClient(client util.EaClient, playerCode int) (days []WorkoutDay, settings WorkoutSettings, err error) {
+	document, err := workoutDocument(client)
+	if err != nil {
+		return
+	}
+	days, err = workoutDaysFromDocument(document, playerCode)
+	if err != nil {
+		return
+	}
+	settings = workoutSettingsFromDocument(document, days)
+	days = uniqueWorkoutDays(days)
+	glog.Infof("loaded %d workout days for user %s\n", len(days), client.GetUsername())
+	return
+}
+
+func workoutDaysFromDocument(document *goquery.Document, playerCode int) (days []WorkoutDay, err error) {
+	format := "2006-01-02"
+	loc, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		return
+	}
+
+	tables := document.Find("table.work_out_table, table#work_out_left")
+	if tables.Length() == 0 {
+		err = fmt.Errorf("could not find work_out_left")
+		return
+	}
+
+	tables.Find("tr").Each(func(i int, s *goquery.Selection) {
+		columns := s.Find("td")
+		if columns.Length() < 4 {
+			return
+		}
+		day := WorkoutDay{}
+		t, err := time.ParseInLocation(format, strings.TrimSpace(columns.Eq(1).Text()), loc)
+		if err != nil {
+			return
+		}
+		day.Date = t
+		day.PlayCount = int(numberFromText(columns.Eq(2).Text()))
+		day.Kcal = float32(numberFromText(columns.Eq(3).Text()))
+		if columns.Length() > 4 {
+			day.Weight = float32(numberFromText(columns.Eq(4).Text()))
+		}
+		day.PlayerCode = playerCode
+		days = append(days, day)
+	})
+	return
+}
+
+func workoutSettingsFromDocument(document *goquery.Document, days []WorkoutDay) (settings WorkoutSettings) {
+	document.Find("table.workout").Each(func(i int, s *goquery.Selection) {
+		details, err := util.TableThTd(s)
+		if err != nil {
+			return
+		}
+		if total, ok := details["トータル消費カロリー"]; ok {
+			settings.TotalKcal = float32(numberFromText(total))
+		}
+		if playCount, ok := details["プレー曲数"]; ok {
+			settings.TodayPlayCount = int(numberFromText(playCount))
+		}
+		if kcal, ok := details["消費カロリー"]; ok {
+			settings.TodayKcal = float32(numberFromText(kcal))
+		}
+		if weight, ok := details["設定体重"]; ok {
+			settings.Weight = float32(numberFromText(weight))
+		}
+		if display, ok := details["体重表示"]; ok {
+			settings.WeightDisplay = display
+		}
+	})
+
+	if settings.Weight == 0 && len(days) > 0 {
+		latest := days[0]
+		for _, day := range days {
+			if day.Date.After(latest.Date) {
+				latest = day
+			}
+		}
+		settings.Weight = latest.Weight
+	}
+	return
+}
+
+// numberFromText will parse the first decimal number in a value such as
+// "343.35 kcal", returning 0 if there is none.
+func numberFromText(text string) float64 {
+	numberExp := regexp.MustCompile("[0-9]+(\\.[0-9]+)?")
+	value, err := strconv.ParseFloat(numberExp.FindString(strings.Replace(text, ",", "", -1)), 64)
+	if err != nil {
+		return 0
+	}
+	return value
+}
+
+// uniqueWorkoutDays removes days loaded more than once and sorts the
+// result newest first.
+func uniqueWorkoutDays(days []WorkoutDay) (unique []WorkoutDay) {
+	seen := make(map[int64]bool)
+	for _, day := range days {
+		if seen[day.Date.Unix()] {
+			continue
+		}
+		seen[day.Date.Unix()] = true
+		unique = append(unique, day)
+	}
+	sort.Slice(unique, func(i, j int) bool {
+		return unique[i].Date.After(unique[j].Date)
+	})
+	return
+}
+
+// DailyWorkoutSummary aggregates workout data per day, including days
+// without plays between the first and last recorded day.
+func DailyWorkoutSummary(workoutData []ddr_models.WorkoutData) []WorkoutSummary {
+	return summariseWorkout(workoutData, func(t time.Time) time.Time {
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	}, func(t time.Time) time.Time {
+		return t.AddDate(0, 0, 1)
+	})
+}
+
+// WeeklyWorkoutSummary aggregates workout data per week, with weeks
+// starting on Monday.
+func WeeklyWorkoutSummary(workoutData []ddr_models.WorkoutData) []WorkoutSummary {
+	return summariseWorkout(workoutData, func(t time.Time) time.Time {
+		offset := (int(t.Weekday()) + 6) % 7
+		return time.Date(t.Year(), t.Month(), t.Day()-offset, 0, 0, 0, 0, t.Location())
+	}, func(t time.Time) time.Time {
+		return t.AddDate(0, 0, 7)
+	})
+}
+
+// MonthlyWorkoutSummary aggregates workout data per calendar month.
+func MonthlyWorkoutSummary(workoutData []ddr_models.WorkoutData) []WorkoutSummary {
+	return summariseWorkout(workoutData, func(t time.Time) time.Time {
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+	}, func(t time.Time) time.Time {
+		return t.AddDate(0, 1, 0)
+	})
+}
+
+// summariseWorkout buckets workout data into consecutive periods, oldest
+// first. periodStart returns the start of the period containing a time
+// and nextPeriod returns the start of the following period.
+func summariseWorkout(workoutData []ddr_models.WorkoutData, periodStart func(time.Time) time.Time, nextPeriod func(time.Time) time.Time) (summaries []WorkoutSummary) {
+	if len(workoutData) == 0 {
+		return
+	}
+
+	first := workoutData[0].Date
+	last := workoutData[0].Date
+	for _, wd := range workoutData {
+		if wd.Date.Before(first) {
+			first = wd.Date
+		}
+		if wd.Date.After(last) {
+			last = wd.Date
+		}
+	}
+
+	index := make(map[int64]int)
+	for start := periodStart(first); !start.After(last); start = nextPeriod(start) {
+		index[start.Unix()] = len(summaries)
+		summaries = append(summaries, WorkoutSummary{
+			Start: start,
+			End:   nextPeriod(start),
+		})
+	}
+
+	for _, wd := range workoutData {
+		i, ok := index[periodStart(wd.Date).Unix()]
+		if !ok {
+			continue
+		}
+		if wd.PlayCount > 0 {
+			summaries[i].ActiveDays++
+		}
+		summaries[i].PlayCount += wd.PlayCount
+		summaries[i].Kcal += wd.Kcal
+	}
+	return
+}
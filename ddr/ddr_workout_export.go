@@ -0,0 +1,362 @@
+package ddr
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/xml"
+	"io"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/chris-sg/eagate_models/ddr_models"
+)
+
+// WorkoutExportOptions tunes how workout days are converted into
+// activities.
+type WorkoutExportOptions struct {
+	// EstimatedSecondsPerPlay is the time assumed for a single song,
+	// including song selection.
+	EstimatedSecondsPerPlay int
+	// SessionGap is the longest break between two plays that are still
+	// considered part of the same session.
+	SessionGap time.Duration
+}
+
+// DefaultWorkoutExportOptions returns the options used for any field left
+// at zero.
+func DefaultWorkoutExportOptions() WorkoutExportOptions {
+	return WorkoutExportOptions{
+		EstimatedSecondsPerPlay: 150,
+		SessionGap:              30 * time.Minute,
+	}
+}
+
+func (options WorkoutExportOptions) withDefaults() WorkoutExportOptions {
+	defaults := DefaultWorkoutExportOptions()
+	if options.EstimatedSecondsPerPlay <= 0 {
+		options.EstimatedSecondsPerPlay = defaults.EstimatedSecondsPerPlay
+	}
+	if options.SessionGap <= 0 {
+		options.SessionGap = defaults.SessionGap
+	}
+	return options
+}
+
+// WorkoutActivity is a single exercise session suitable for exporting to
+// fitness applications.
+type WorkoutActivity struct {
+	Start     time.Time
+	Duration  time.Duration
+	PlayCount int
+	Kcal      float32
+}
+
+// WorkoutActivities will convert workout days into activities. Where
+// recent scores are available for a day, the day is split into the
+// sessions inferred from the play times, with the day's calories shared
+// by play count. Other days become a single activity starting at the
+// beginning of the day.
+//
+// The recent scores page only lists the latest 50 plays, so a day may
+// have more plays than scores. As the missing plays are the oldest, they
+// are added to the start of the day's first session, keeping the
+// activities' play counts equal to the day's.
+func WorkoutActivities(workoutData []ddr_models.WorkoutData, scores []ddr_models.Score, options WorkoutExportOptions) (activities []WorkoutActivity) {
+	options = options.withDefaults()
+
+	scoresByDay := make(map[string][]ddr_models.Score)
+	for _, score := range scores {
+		day := score.TimePlayed.In(workoutLocation()).Format("2006-01-02")
+		scoresByDay[day] = append(scoresByDay[day], score)
+	}
+
+	for _, wd := range workoutData {
+		if wd.PlayCount == 0 {
+			continue
+		}
+		day := wd.Date.In(workoutLocation()).Format("2006-01-02")
+		sessions := sessionsFromScores(scoresByDay[day], options)
+		if len(sessions) == 0 {
+			activities = append(activities, WorkoutActivity{
+				Start:     wd.Date,
+				Duration:  estimatedPlayDuration(wd.PlayCount, options),
+				PlayCount: wd.PlayCount,
+				Kcal:      wd.Kcal,
+			})
+			continue
+		}
+
+		sessionPlays := 0
+		for _, session := range sessions {
+			sessionPlays += session.PlayCount
+		}
+		if unlisted := wd.PlayCount - sessionPlays; unlisted > 0 {
+			extra := estimatedPlayDuration(unlisted, options)
+			sessions[0].Start = sessions[0].Start.Add(-extra)
+			sessions[0].Duration += extra
+			sessions[0].PlayCount += unlisted
+			sessionPlays += unlisted
+		}
+		for _, session := range sessions {
+			session.Kcal = wd.Kcal * float32(session.PlayCount) / float32(sessionPlays)
+			activities = append(activities, session)
+		}
+	}
+
+	sort.Slice(activities, func(i, j int) bool {
+		return activities[i].Start.Before(activities[j].Start)
+	})
+	return
+}
+
+// sessionsFromScores groups plays separated by less than the session gap
+// into activities without calories.
+func sessionsFromScores(scores []ddr_models.Score, options WorkoutExportOptions) (sessions []WorkoutActivity) {
+	if len(scores) == 0 {
+		return
+	}
+	sorted := make([]ddr_models.Score, len(scores))
+	copy(sorted, scores)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].TimePlayed.Before(sorted[j].TimePlayed)
+	})
+
+	perPlay := estimatedPlayDuration(1, options)
+	current := WorkoutActivity{Start: sorted[0].TimePlayed.Add(-perPlay), PlayCount: 1}
+	last := sorted[0].TimePlayed
+	for _, score := range sorted[1:] {
+		if score.TimePlayed.Sub(last) > options.SessionGap {
+			current.Duration = last.Sub(current.Start)
+			sessions = append(sessions, current)
+			current = WorkoutActivity{Start: score.TimePlayed.Add(-perPlay)}
+		}
+		current.PlayCount++
+		last = score.TimePlayed
+	}
+	current.Duration = last.Sub(current.Start)
+	sessions = append(sessions, current)
+	return
+}
+
+func estimatedPlayDuration(playCount int, options WorkoutExportOptions) time.Duration {
+	return time.Duration(playCount*options.EstimatedSecondsPerPlay) * time.Second
+}
+
+func workoutLocation() *time.Location {
+	loc, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// tcxDatabase mirrors the parts of the Garmin TrainingCenterDatabase v2
+// schema used for workout exports.
+type tcxDatabase struct {
+	XMLName    xml.Name      `xml:"TrainingCenterDatabase"`
+	Xmlns      string        `xml:"xmlns,attr"`
+	Activities []tcxActivity `xml:"Activities>Activity"`
+}
+
+type tcxActivity struct {
+	Sport string `xml:"Sport,attr"`
+	Id    string `xml:"Id"`
+	Lap   tcxLap `xml:"Lap"`
+	Notes string `xml:"Notes,omitempty"`
+}
+
+type tcxLap struct {
+	StartTime        string  `xml:"StartTime,attr"`
+	TotalTimeSeconds float64 `xml:"TotalTimeSeconds"`
+	DistanceMeters   float64 `xml:"DistanceMeters"`
+	Calories         uint16  `xml:"Calories"`
+	Intensity        string  `xml:"Intensity"`
+	TriggerMethod    string  `xml:"TriggerMethod"`
+}
+
+// WriteTcx will write the activities as a Garmin TCX document.
+func WriteTcx(w io.Writer, activities []WorkoutActivity) error {
+	database := tcxDatabase{
+		Xmlns: "http://www.garmin.com/xmlschemas/TrainingCenterDatabase/v2",
+	}
+	for _, activity := range activities {
+		start := activity.Start.UTC().Format(time.RFC3339)
+		database.Activities = append(database.Activities, tcxActivity{
+			Sport: "Other",
+			Id:    start,
+			Lap: tcxLap{
+				StartTime:        start,
+				TotalTimeSeconds: activity.Duration.Seconds(),
+				Calories:         fitCalories(activity.Kcal),
+				Intensity:        "Active",
+				TriggerMethod:    "Manual",
+			},
+			Notes: "DanceDanceRevolution",
+		})
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	return encoder.Encode(database)
+}
+
+// FIT message and field definitions used for workout exports, taken from
+// the FIT SDK profile.
+const (
+	fitMesgFileId   = 0
+	fitMesgSession  = 18
+	fitMesgLap      = 19
+	fitMesgEvent    = 21
+	fitMesgActivity = 34
+
+	fitBaseEnum    = 0x00
+	fitBaseUint16  = 0x84
+	fitBaseUint32  = 0x86
+	fitBaseUint32z = 0x8C
+
+	fitFieldTimestamp = 253
+)
+
+// fitEpoch is the zero time of FIT timestamps.
+var fitEpoch = time.Date(1989, 12, 31, 0, 0, 0, 0, time.UTC)
+
+type fitField struct {
+	number   byte
+	size     byte
+	baseType byte
+	value    uint32
+}
+
+// WriteFit will write a single activity as a FIT activity file.
+func WriteFit(w io.Writer, activity WorkoutActivity) error {
+	var records bytes.Buffer
+
+	start := fitTimestamp(activity.Start)
+	end := fitTimestamp(activity.Start.Add(activity.Duration))
+	elapsed := uint32(activity.Duration.Seconds() * 1000)
+	calories := uint32(fitCalories(activity.Kcal))
+
+	writeFitMessage(&records, 0, fitMesgFileId, []fitField{
+		{0, 1, fitBaseEnum, 4},       // type: activity
+		{1, 2, fitBaseUint16, 255},   // manufacturer: development
+		{2, 2, fitBaseUint16, 0},     // product
+		{3, 4, fitBaseUint32z, 1},    // serial_number
+		{4, 4, fitBaseUint32, start}, // time_created
+	})
+	writeFitMessage(&records, 1, fitMesgEvent, []fitField{
+		{fitFieldTimestamp, 4, fitBaseUint32, start},
+		{0, 1, fitBaseEnum, 0}, // event: timer
+		{1, 1, fitBaseEnum, 0}, // event_type: start
+	})
+	writeFitMessage(&records, 1, fitMesgEvent, []fitField{
+		{fitFieldTimestamp, 4, fitBaseUint32, end},
+		{0, 1, fitBaseEnum, 0}, // event: timer
+		{1, 1, fitBaseEnum, 4}, // event_type: stop_all
+	})
+	writeFitMessage(&records, 2, fitMesgLap, []fitField{
+		{fitFieldTimestamp, 4, fitBaseUint32, end},
+		{2, 4, fitBaseUint32, start},     // start_time
+		{7, 4, fitBaseUint32, elapsed},   // total_elapsed_time
+		{8, 4, fitBaseUint32, elapsed},   // total_timer_time
+		{11, 2, fitBaseUint16, calories}, // total_calories
+		{0, 1, fitBaseEnum, 9},           // event: lap
+		{1, 1, fitBaseEnum, 1},           // event_type: stop
+	})
+	writeFitMessage(&records, 3, fitMesgSession, []fitField{
+		{fitFieldTimestamp, 4, fitBaseUint32, end},
+		{2, 4, fitBaseUint32, start},     // start_time
+		{7, 4, fitBaseUint32, elapsed},   // total_elapsed_time
+		{8, 4, fitBaseUint32, elapsed},   // total_timer_time
+		{11, 2, fitBaseUint16, calories}, // total_calories
+		{5, 1, fitBaseEnum, 0},           // sport: generic
+		{25, 2, fitBaseUint16, 0},        // first_lap_index
+		{26, 2, fitBaseUint16, 1},        // num_laps
+		{0, 1, fitBaseEnum, 8},           // event: session
+		{1, 1, fitBaseEnum, 1},           // event_type: stop
+	})
+	writeFitMessage(&records, 4, fitMesgActivity, []fitField{
+		{fitFieldTimestamp, 4, fitBaseUint32, end},
+		{0, 4, fitBaseUint32, elapsed}, // total_timer_time
+		{1, 2, fitBaseUint16, 1},       // num_sessions
+		{2, 1, fitBaseEnum, 0},         // type: manual
+		{3, 1, fitBaseEnum, 26},        // event: activity
+		{4, 1, fitBaseEnum, 1},         // event_type: stop
+	})
+
+	header := make([]byte, 14)
+	header[0] = 14
+	header[1] = 0x10
+	binary.LittleEndian.PutUint16(header[2:4], 2093)
+	binary.LittleEndian.PutUint32(header[4:8], uint32(records.Len()))
+	copy(header[8:12], ".FIT")
+	binary.LittleEndian.PutUint16(header[12:14], fitCrc(0, header[:12]))
+
+	crc := fitCrc(fitCrc(0, header), records.Bytes())
+	trailer := make([]byte, 2)
+	binary.LittleEndian.PutUint16(trailer, crc)
+
+	for _, b := range [][]byte{header, records.Bytes(), trailer} {
+		if _, err := w.Write(b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeFitMessage writes a definition message followed by a data message
+// for the given local message type.
+func writeFitMessage(buf *bytes.Buffer, localType byte, globalMesg uint16, fields []fitField) {
+	buf.WriteByte(0x40 | localType)
+	buf.WriteByte(0)
+	buf.WriteByte(0)
+	binary.Write(buf, binary.LittleEndian, globalMesg)
+	buf.WriteByte(byte(len(fields)))
+	for _, field := range fields {
+		buf.Write([]byte{field.number, field.size, field.baseType})
+	}
+
+	buf.WriteByte(localType)
+	for _, field := range fields {
+		switch field.size {
+		case 1:
+			buf.WriteByte(byte(field.value))
+		case 2:
+			binary.Write(buf, binary.LittleEndian, uint16(field.value))
+		case 4:
+			binary.Write(buf, binary.LittleEndian, field.value)
+		}
+	}
+}
+
+func fitTimestamp(t time.Time) uint32 {
+	return uint32(t.Sub(fitEpoch) / time.Second)
+}
+
+func fitCalories(kcal float32) uint16 {
+	if kcal <= 0 {
+		return 0
+	}
+	return uint16(math.Min(math.Round(float64(kcal)), math.MaxUint16-1))
+}
+
+// fitCrc computes the CRC-16 used by FIT headers and files.
+func fitCrc(crc uint16, data []byte) uint16 {
+	crcTable := [16]uint16{
+		0x0000, 0xCC01, 0xD801, 0x1400, 0xF001, 0x3C00, 0x2800, 0xE401,
+		0xA001, 0x6C00, 0x7800, 0xB401, 0x5000, 0x9C01, 0x8801, 0x4400,
+	}
+	for _, b := range data {
+		tmp := crcTable[crc&0xF]
+		crc = (crc >> 4) & 0x0FFF
+		crc = crc ^ tmp ^ crcTable[b&0xF]
+
+		tmp = crcTable[crc&0xF]
+		crc = (crc >> 4) & 0x0FFF
+		crc = crc ^ tmp ^ crcTable[(b>>4)&0xF]
+	}
+	return crc
+}
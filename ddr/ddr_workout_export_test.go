@@ -0,0 +1,128 @@
+package ddr
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/xml"
+	"github.com/chris-sg/eagate_models/ddr_models"
+	"testing"
+	"time"
+)
+
+func TestWorkoutActivities(t *testing.T) {
+	// Setup test
+	loc := workoutLocation()
+	day := time.Date(2020, 3, 18, 0, 0, 0, 0, loc)
+	workoutData := []ddr_models.WorkoutData{
+		{Date: day, PlayCount: 4, Kcal: 100},
+		{Date: day.AddDate(0, 0, -2), PlayCount: 3, Kcal: 90},
+	}
+	scores := []ddr_models.Score{
+		{TimePlayed: day.Add(18 * time.Hour)},
+		{TimePlayed: day.Add(18*time.Hour + 3*time.Minute)},
+		{TimePlayed: day.Add(18*time.Hour + 6*time.Minute)},
+		{TimePlayed: day.Add(21 * time.Hour)},
+	}
+
+	// Run Test
+	activities := WorkoutActivities(workoutData, scores, DefaultWorkoutExportOptions())
+	if len(activities) != 3 {
+		t.Fatalf("expected 3 activities, got %d: %+#v", len(activities), activities)
+	}
+	if !activities[0].Start.Equal(day.AddDate(0, 0, -2)) || activities[0].Duration != 450*time.Second {
+		t.Errorf("unexpected activity for a day without scores: %+#v", activities[0])
+	}
+	if activities[1].PlayCount != 3 || activities[1].Kcal != 75 || activities[1].Duration != 8*time.Minute+30*time.Second {
+		t.Errorf("unexpected first inferred session: %+#v", activities[1])
+	}
+	if activities[2].PlayCount != 1 || activities[2].Kcal != 25 {
+		t.Errorf("unexpected second inferred session: %+#v", activities[2])
+	}
+}
+
+func TestWorkoutActivitiesWithUnlistedPlays(t *testing.T) {
+	// Setup test
+	loc := workoutLocation()
+	day := time.Date(2020, 3, 18, 0, 0, 0, 0, loc)
+	workoutData := []ddr_models.WorkoutData{
+		{Date: day, PlayCount: 6, Kcal: 120},
+	}
+	scores := []ddr_models.Score{
+		{TimePlayed: day.Add(18 * time.Hour)},
+		{TimePlayed: day.Add(21 * time.Hour)},
+	}
+	options := WorkoutExportOptions{EstimatedSecondsPerPlay: 120}
+
+	// Setup expected results
+	expectedFirst := WorkoutActivity{
+		Start:     day.Add(18*time.Hour - 10*time.Minute),
+		Duration:  10 * time.Minute,
+		PlayCount: 5,
+		Kcal:      100,
+	}
+
+	// Run Test
+	activities := WorkoutActivities(workoutData, scores, options)
+	if len(activities) != 2 {
+		t.Fatalf("expected 2 activities, got %d: %+#v", len(activities), activities)
+	}
+	if !activities[0].Start.Equal(expectedFirst.Start) ||
+		activities[0].Duration != expectedFirst.Duration ||
+		activities[0].PlayCount != expectedFirst.PlayCount ||
+		activities[0].Kcal != expectedFirst.Kcal {
+		t.Errorf("expected first session %+#v, got %+#v", expectedFirst, activities[0])
+	}
+	if activities[1].PlayCount != 1 || activities[1].Kcal != 20 {
+		t.Errorf("unexpected second session: %+#v", activities[1])
+	}
+}
+
+func TestWriteTcx(t *testing.T) {
+	// Setup test
+	activities := []WorkoutActivity{
+		{Start: time.Date(2020, 3, 18, 9, 0, 0, 0, time.UTC), Duration: time.Hour, PlayCount: 20, Kcal: 612.6},
+	}
+
+	// Run Test
+	var b bytes.Buffer
+	if err := WriteTcx(&b, activities); err != nil {
+		t.Fatalf("failed to write tcx: %s", err.Error())
+	}
+
+	var database tcxDatabase
+	if err := xml.Unmarshal(b.Bytes(), &database); err != nil {
+		t.Fatalf("failed to read back tcx: %s", err.Error())
+	}
+	if len(database.Activities) != 1 ||
+		database.Activities[0].Id != "2020-03-18T09:00:00Z" ||
+		database.Activities[0].Lap.TotalTimeSeconds != 3600 ||
+		database.Activities[0].Lap.Calories != 613 {
+		t.Errorf("unexpected tcx contents: %s", b.String())
+	}
+}
+
+func TestWriteFit(t *testing.T) {
+	// Setup test
+	activity := WorkoutActivity{Start: time.Date(2020, 3, 18, 9, 0, 0, 0, time.UTC), Duration: time.Hour, PlayCount: 20, Kcal: 612.6}
+
+	// Run Test
+	var b bytes.Buffer
+	if err := WriteFit(&b, activity); err != nil {
+		t.Fatalf("failed to write fit: %s", err.Error())
+	}
+	data := b.Bytes()
+
+	if len(data) < 16 || data[0] != 14 || string(data[8:12]) != ".FIT" {
+		t.Fatalf("invalid fit header: %v", data[:14])
+	}
+	if fitCrc(0, data[:14]) != 0 {
+		t.Errorf("fit header crc does not validate")
+	}
+	dataSize := binary.LittleEndian.Uint32(data[4:8])
+	if int(dataSize) != len(data)-16 {
+		t.Errorf("expected a data size of %d, got %d", len(data)-16, dataSize)
+	}
+	if fitCrc(0, data) != 0 {
+		t.Errorf("fit file crc does not validate")
+	}
+}
@@ -0,0 +1,69 @@
+package ddr
+
+import (
+	"github.com/chris-sg/eagate_models/ddr_models"
+	"testing"
+	"time"
+)
+
+func TestWorkoutSettingsFromDocument(t *testing.T) {
+	// Setup test
+	const testFile = "./test_data/player/workout.html"
+
+	// Run Test
+	document, err := documentFromFile(testFile)
+	if err != nil {
+		t.Fatalf("could not load %s: %s", testFile, err.Error())
+	}
+
+	days, err := workoutDaysFromDocument(document, 12345678)
+	if err != nil {
+		t.Fatalf("failed to load workout days from document: %s", err.Error())
+	}
+	if len(days) == 0 || days[0].Weight != 10 {
+		t.Errorf("expected the first workout day to have a weight of 10kg, got %+#v", days)
+	}
+
+	settings := workoutSettingsFromDocument(document, days)
+	if settings.TotalKcal != 45749.553 ||
+		settings.TodayPlayCount != 0 ||
+		settings.TodayKcal != 0 ||
+		settings.Weight != 10 {
+		t.Errorf("workout settings did not match, got %+#v", settings)
+	}
+}
+
+func TestWorkoutSummaries(t *testing.T) {
+	// Setup test
+	day := func(date string, playCount int, kcal float32) ddr_models.WorkoutData {
+		d, _ := time.Parse("2006-01-02", date)
+		return ddr_models.WorkoutData{Date: d, PlayCount: playCount, Kcal: kcal}
+	}
+	workoutData := []ddr_models.WorkoutData{
+		day("2020-03-18", 11, 343.5),
+		day("2020-03-16", 17, 614),
+		day("2020-02-28", 4, 100),
+	}
+
+	// Run Test
+	daily := DailyWorkoutSummary(workoutData)
+	if len(daily) != 20 {
+		t.Errorf("expected 20 daily summaries, got %d", len(daily))
+	}
+
+	weekly := WeeklyWorkoutSummary(workoutData)
+	if len(weekly) != 4 {
+		t.Fatalf("expected 4 weekly summaries, got %d", len(weekly))
+	}
+	if weekly[0].Start.Weekday() != time.Monday || weekly[0].PlayCount != 4 {
+		t.Errorf("unexpected first week summary %+#v", weekly[0])
+	}
+	if weekly[3].ActiveDays != 2 || weekly[3].PlayCount != 28 || weekly[3].Kcal != 957.5 {
+		t.Errorf("unexpected last week summary %+#v", weekly[3])
+	}
+
+	monthly := MonthlyWorkoutSummary(workoutData)
+	if len(monthly) != 2 || monthly[0].PlayCount != 4 || monthly[1].PlayCount != 28 {
+		t.Errorf("unexpected monthly summaries %+#v", monthly)
+	}
+}
@@ -0,0 +1,127 @@
+package drs
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/chris-sg/eagate/util"
+	"github.com/golang/glog"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// PdataKind is a service_kind and pdata_kind pair accepted by the
+// pdata_getdata API.
+type PdataKind struct {
+	ServiceKind string
+	PdataKind   string
+}
+
+var (
+	DancerInfoKind = PdataKind{"dancer_info", "dancer_info"}
+	MusicDataKind  = PdataKind{"music_data", "music_data"}
+	PlayHistKind   = PdataKind{"play_hist", "play_hist"}
+	RivalKind      = PdataKind{"rival", "rival"}
+	RankingKind    = PdataKind{"ranking", "ranking"}
+	EventKind      = PdataKind{"event", "event"}
+)
+
+// ApiError is returned when the pdata_getdata API responds, but reports
+// a failure in its status or result fields.
+type ApiError struct {
+	Kind       PdataKind
+	Status     int
+	DataStatus int
+	Result     string
+}
+
+func (e ApiError) Error() string {
+	return fmt.Sprintf("pdata_getdata %s/%s failed: status %d, data status %d, result %s",
+		e.Kind.ServiceKind, e.Kind.PdataKind, e.Status, e.DataStatus, e.Result)
+}
+
+// pdataEnvelope holds the fields every pdata_getdata response uses to
+// report errors. The result is a string for some kinds and a number for
+// others.
+type pdataEnvelope struct {
+	Status int `json:"status"`
+	Data   struct {
+		Status     int `json:"status"`
+		PlayerData struct {
+			Result json.RawMessage `json:"result"`
+		} `json:"easite_get_playerdata"`
+	} `json:"data"`
+}
+
+// LoadPdata will request kind from the pdata_getdata API of the default
+// version, check the response for errors and decode it into v. params
+// are added to the request form and may be nil.
+func LoadPdata(client util.EaClient, kind PdataKind, params url.Values, v interface{}) (err error) {
+	return LoadPdataForVersion(client, DefaultVersion(), kind, params, v)
+}
+
+// LoadPdataForVersion will request kind from the pdata_getdata API of
+// version, check the response for errors and decode it into v.
+func LoadPdataForVersion(client util.EaClient, version Version, kind PdataKind, params url.Values, v interface{}) (err error) {
+	pdataURI := util.BuildEaURI(version.pdataResource())
+
+	form := url.Values{}
+	for k, values := range params {
+		for _, value := range values {
+			form.Add(k, value)
+		}
+	}
+	form.Set("service_kind", kind.ServiceKind)
+	form.Set("pdata_kind", kind.PdataKind)
+
+	req, err := http.NewRequest(http.MethodPost, pdataURI, strings.NewReader(form.Encode()))
+	if err != nil {
+		return
+	}
+	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+
+	glog.Infof("retrieving resource %s (%s/%s)\n", pdataURI, kind.ServiceKind, kind.PdataKind)
+	res, err := client.Client.Do(req)
+	if err != nil {
+		glog.Errorf("failed to get resource %s: %s\n", pdataURI, err.Error())
+		return
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		err = fmt.Errorf("pdata_getdata %s/%s returned status %d", kind.ServiceKind, kind.PdataKind, res.StatusCode)
+		return
+	}
+
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return
+	}
+	if strings.Contains(res.Header.Get("Content-Type"), "Windows-31J") {
+		body = util.ShiftJISBytesToUTF8Bytes(body)
+	}
+
+	err = pdataFromBytes(version, kind, body, v)
+	return
+}
+
+// pdataFromBytes checks a pdata_getdata response for errors before
+// decoding it into v.
+func pdataFromBytes(version Version, kind PdataKind, body []byte, v interface{}) (err error) {
+	var envelope pdataEnvelope
+	if err = json.Unmarshal(body, &envelope); err != nil {
+		return fmt.Errorf("failed to decode pdata_getdata %s/%s: %s", kind.ServiceKind, kind.PdataKind, err.Error())
+	}
+	result := strings.Trim(string(bytes.TrimSpace(envelope.Data.PlayerData.Result)), "\"")
+	if envelope.Status != 0 || envelope.Data.Status != 0 || (result != "" && result != "0") {
+		return ApiError{
+			Kind:       kind,
+			Status:     envelope.Status,
+			DataStatus: envelope.Data.Status,
+			Result:     result,
+		}
+	}
+	return version.decode(kind, body, v)
+}
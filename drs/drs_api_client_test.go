@@ -0,0 +1,77 @@
+package drs
+
+import (
+	"github.com/chris-sg/eagate/util"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func testServerAndClient(uriMapping map[string]string) (util.EaClient, *httptest.Server) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	}))
+	client := util.GenerateClient()
+	client.SetTestClient(ts, uriMapping)
+
+	return client, ts
+}
+
+func TestLoadDancerInfo(t *testing.T) {
+	// Setup test
+	client, ts := testServerAndClient(map[string]string{
+		util.BuildEaURI(DefaultVersion().pdataResource()): "./test_data/pdata/dancer_info.json",
+	})
+	defer ts.Close()
+
+	// Run Test
+	dancerInfo, err := LoadDancerInfo(client)
+	if err != nil {
+		t.Fatalf("unexpected error %s", err.Error())
+	}
+	if dancerInfo.Data.EaSite.Profile.Name != "DANCER" || dancerInfo.Data.EaSite.Statistics.PlayCount != 120 {
+		t.Errorf("unexpected dancer info %+#v", dancerInfo)
+	}
+}
+
+func TestLoadPdataHttpError(t *testing.T) {
+	// Setup test
+	client, ts := testServerAndClient(map[string]string{})
+	defer ts.Close()
+
+	// Run Test
+	if _, err := LoadMusicData(client); err == nil {
+		t.Errorf("expected an error for a bad request")
+	}
+}
+
+func TestPdataFromBytes(t *testing.T) {
+	// Setup test
+	rivalBody, err := ioutil.ReadFile("./test_data/pdata/rival.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	errorBody, err := ioutil.ReadFile("./test_data/pdata/error.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Run Test
+	var rivals RivalData
+	if err := pdataFromBytes(DefaultVersion(), RivalKind, rivalBody, &rivals); err != nil {
+		t.Fatalf("unexpected error %s", err.Error())
+	}
+	if r := rivals.Data.PlayerData.Rivals.Rival; len(r) != 1 || r[0].Code != 12345678 || r[0].Name != "RIVAL" {
+		t.Errorf("unexpected rivals %+#v", r)
+	}
+
+	err = pdataFromBytes(DefaultVersion(), RankingKind, errorBody, &RankingData{})
+	apiErr, ok := err.(ApiError)
+	if !ok || apiErr.Result != "12" || apiErr.Kind != RankingKind {
+		t.Errorf("expected an ApiError with result 12, got %v", err)
+	}
+
+	if err := pdataFromBytes(DefaultVersion(), RankingKind, []byte("bad"), &RankingData{}); err == nil {
+		t.Errorf("expected an error for invalid json")
+	}
+}
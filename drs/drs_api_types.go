@@ -0,0 +1,68 @@
+package drs
+
+// RivalData is the pdata_getdata response for RivalKind.
+type RivalData struct {
+	Status int `json:"status"`
+	Data   struct {
+		Status     int `json:"status"`
+		PlayerData struct {
+			Result int `json:"result"`
+			Rivals struct {
+				Rival []Rival `json:"rival"`
+			} `json:"rival"`
+		} `json:"easite_get_playerdata"`
+	} `json:"data"`
+}
+
+// Rival is a dancer registered as a rival.
+type Rival struct {
+	Code      int    `json:"member_code"`
+	Name      string `json:"name"`
+	PlayCount int    `json:"play_cnt"`
+}
+
+// RankingData is the pdata_getdata response for RankingKind.
+type RankingData struct {
+	Status int `json:"status"`
+	Data   struct {
+		Status     int `json:"status"`
+		PlayerData struct {
+			Result  int `json:"result"`
+			Ranking struct {
+				Music []ChartRanking `json:"music"`
+			} `json:"ranking"`
+		} `json:"easite_get_playerdata"`
+	} `json:"data"`
+}
+
+// ChartRanking is the player's national position on a chart.
+type ChartRanking struct {
+	MusicId   string `json:"music_id"`
+	MusicType string `json:"music_type"`
+	Rank      int    `json:"rank"`
+	Entries   int    `json:"entry_cnt"`
+	Score     int    `json:"score"`
+}
+
+// EventData is the pdata_getdata response for EventKind.
+type EventData struct {
+	Status int `json:"status"`
+	Data   struct {
+		Status     int `json:"status"`
+		PlayerData struct {
+			Result int   `json:"result"`
+			Event  Event `json:"event"`
+		} `json:"easite_get_playerdata"`
+	} `json:"data"`
+}
+
+// Event is the player's progress in an event. Dates are unix
+// milliseconds, as with the other pdata_getdata kinds.
+type Event struct {
+	EventId   string `json:"event_id"`
+	Name      string `json:"name"`
+	Point     int    `json:"point"`
+	Rank      int    `json:"rank"`
+	StartDate int64  `json:"start_date"`
+	EndDate   int64  `json:"end_date"`
+}
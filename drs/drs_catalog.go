@@ -0,0 +1,219 @@
+package drs
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/chris-sg/eagate/util"
+	"github.com/chris-sg/eagate_models/drs_models"
+	"github.com/golang/glog"
+)
+
+// CatalogSong is a song in the SongCatalog, along with how it has been
+// seen across syncs.
+type CatalogSong struct {
+	drs_models.Song
+	Difficulties []drs_models.Difficulty `json:"difficulties"`
+
+	// Licensed is set for songs with a license notice, which are usually
+	// licensed from outside Konami.
+	Licensed bool `json:"licensed"`
+	// Limited is set for songs with a LimitationType, which are only
+	// playable for a limited time or after being unlocked.
+	Limited bool `json:"limited"`
+
+	JacketHash string `json:"jackethash"`
+
+	FirstSeen time.Time `json:"firstseen"`
+	LastSeen  time.Time `json:"lastseen"`
+	// Removed is set when the song was missing from the latest complete
+	// sync.
+	Removed bool `json:"removed"`
+}
+
+// SongCatalog is a persistent catalog of DRS songs merged from the music
+// databases of any number of accounts.
+type SongCatalog struct {
+	Songs    map[string]*CatalogSong `json:"songs"`
+	LastSync time.Time               `json:"lastsync"`
+}
+
+// CatalogSyncResult lists the song ids changed by a sync.
+type CatalogSyncResult struct {
+	Added    []string
+	Removed  []string
+	Restored []string
+	// Warnings are the problems found while reading each account's music
+	// database.
+	Warnings []TransformWarning
+}
+
+func NewSongCatalog() *SongCatalog {
+	return &SongCatalog{Songs: make(map[string]*CatalogSong)}
+}
+
+// ReadSongCatalog will load a catalog previously written with Write.
+func ReadSongCatalog(r io.Reader) (catalog *SongCatalog, err error) {
+	catalog = NewSongCatalog()
+	err = json.NewDecoder(r).Decode(catalog)
+	return
+}
+
+// Write will persist the catalog as JSON.
+func (catalog *SongCatalog) Write(w io.Writer) error {
+	return json.NewEncoder(w).Encode(catalog)
+}
+
+// Merge will add or update songs and their difficulties in the catalog.
+// Difficulties from every merge are kept, so an account that is missing
+// a chart does not remove it.
+func (catalog *SongCatalog) Merge(songs []drs_models.Song, difficulties []drs_models.Difficulty, syncTime time.Time) (added []string, restored []string) {
+	for _, song := range songs {
+		existing, ok := catalog.Songs[song.SongId]
+		if !ok {
+			existing = &CatalogSong{FirstSeen: syncTime}
+			catalog.Songs[song.SongId] = existing
+			added = append(added, song.SongId)
+		} else if existing.Removed {
+			existing.Removed = false
+			restored = append(restored, song.SongId)
+		}
+		existing.Song = song
+		existing.Licensed = song.License != ""
+		existing.Limited = song.LimitationType != 0
+		existing.LastSeen = syncTime
+	}
+
+	for _, difficulty := range difficulties {
+		song, ok := catalog.Songs[difficulty.SongId]
+		if !ok {
+			continue
+		}
+		replaced := false
+		for i, existing := range song.Difficulties {
+			if existing.Mode == difficulty.Mode && existing.Difficulty == difficulty.Difficulty {
+				song.Difficulties[i] = difficulty
+				replaced = true
+			}
+		}
+		if !replaced {
+			song.Difficulties = append(song.Difficulties, difficulty)
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(restored)
+	return
+}
+
+// MarkRemoved will flag every song not seen since syncTime as removed,
+// returning the songs that were newly flagged.
+func (catalog *SongCatalog) MarkRemoved(syncTime time.Time) (removed []string) {
+	for songId, song := range catalog.Songs {
+		if !song.Removed && song.LastSeen.Before(syncTime) {
+			song.Removed = true
+			removed = append(removed, songId)
+		}
+	}
+	sort.Strings(removed)
+	catalog.LastSync = syncTime
+	return
+}
+
+// SongCatalogForClients will load the music database of each client and
+// merge them into the catalog. Songs are only flagged as removed when
+// every client loaded, so a failed account cannot empty the catalog. If
+// store is not nil and the version has a JacketResource, missing jackets
+// are fetched into it.
+func SongCatalogForClients(clients []util.EaClient, version Version, catalog *SongCatalog, store util.BlobStore) (result CatalogSyncResult, err error) {
+	if len(clients) == 0 {
+		err = fmt.Errorf("no clients to load music data with")
+		return
+	}
+	syncTime := time.Now()
+	mtx := &sync.Mutex{}
+
+	wg := new(sync.WaitGroup)
+	wg.Add(len(clients))
+
+	errCount := 0
+
+	for _, c := range clients {
+		go func(client util.EaClient) {
+			defer wg.Done()
+			musicData, err := LoadMusicDataForVersion(client, version)
+
+			mtx.Lock()
+			defer mtx.Unlock()
+			if err != nil {
+				glog.Errorf("failed to load music data for user %s: %s\n", client.GetUsername(), err.Error())
+				errCount++
+				return
+			}
+			songs, difficulties := songsFromMusicData(version, musicData, &result.Warnings)
+			added, restored := catalog.Merge(songs, difficulties, syncTime)
+			result.Added = append(result.Added, added...)
+			result.Restored = append(result.Restored, restored...)
+		}(c)
+	}
+
+	wg.Wait()
+	sort.Strings(result.Added)
+	sort.Strings(result.Restored)
+
+	if errCount > 0 {
+		err = fmt.Errorf("failed to load music data for %d/%d clients", errCount, len(clients))
+		return
+	}
+	result.Removed = catalog.MarkRemoved(syncTime)
+
+	if store != nil && version.JacketResource != "" {
+		err = catalog.loadJackets(clients[0], version, store)
+	}
+	return
+}
+
+// loadJackets fetches the jacket of every song that does not have one
+// into store through a util.JacketCache.
+func (catalog *SongCatalog) loadJackets(client util.EaClient, version Version, store util.BlobStore) (err error) {
+	cache := util.NewJacketCache(client, store, catalogJacketPrefix)
+	errCount := 0
+	missing := 0
+	for songId, song := range catalog.Songs {
+		if song.JacketHash != "" || song.Removed {
+			continue
+		}
+		missing++
+		jacket, jacketErr := cache.Jacket(util.BuildEaURI(version.jacketPath(songId)))
+		if jacketErr != nil {
+			glog.Errorf("failed to load jacket for song id %s: %s\n", songId, jacketErr.Error())
+			errCount++
+			continue
+		}
+		song.JacketHash = jacket.Hash
+	}
+	if errCount > 0 {
+		err = fmt.Errorf("failed to load jackets for %d/%d songs", errCount, missing)
+	}
+	return
+}
+
+// catalogJacketPrefix is the BlobStore key prefix of catalog jackets.
+const catalogJacketPrefix = "drs/jackets/"
+
+// catalogJacketKey returns the blob key of a jacket with the given hash.
+func catalogJacketKey(hash string) string {
+	return util.JacketBlobKey(catalogJacketPrefix, hash)
+}
+
+// Jacket returns the stored jacket for a song.
+func (catalog *SongCatalog) Jacket(store util.BlobStore, songId string) ([]byte, error) {
+	song, ok := catalog.Songs[songId]
+	if !ok || song.JacketHash == "" {
+		return nil, util.ErrBlobNotFound
+	}
+	return store.Get(catalogJacketKey(song.JacketHash))
+}
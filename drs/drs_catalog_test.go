@@ -0,0 +1,105 @@
+package drs
+
+import (
+	"bytes"
+	"github.com/chris-sg/eagate/util"
+	"reflect"
+	"testing"
+)
+
+func TestSongCatalogForClients(t *testing.T) {
+	// Setup test
+	version := DefaultVersion()
+	version.JacketResource = "/game/dan/1st/test/jacket.html?id={id}"
+	pdataURI := util.BuildEaURI(version.pdataResource())
+	jackets := map[string]string{
+		util.BuildEaURI(version.jacketPath("1001")): "./test_data/jacket/1001.bin",
+		util.BuildEaURI(version.jacketPath("1002")): "./test_data/jacket/1002.bin",
+	}
+	first, ts1 := testServerAndClient(map[string]string{pdataURI: "./test_data/pdata/music_data.json"})
+	defer ts1.Close()
+	for k, v := range jackets {
+		first.Client.Transport.(util.TestClientProxy).ResponseMap[k] = v
+	}
+	second, ts2 := testServerAndClient(map[string]string{pdataURI: "./test_data/pdata/music_data_2.json"})
+	defer ts2.Close()
+	failing, ts3 := testServerAndClient(map[string]string{})
+	defer ts3.Close()
+
+	catalog := NewSongCatalog()
+	store := util.NewMemoryBlobStore()
+
+	// Run Test
+	result, err := SongCatalogForClients([]util.EaClient{first, second}, version, catalog, store)
+	if err != nil {
+		t.Fatalf("unexpected error %s", err.Error())
+	}
+	if !reflect.DeepEqual(result.Added, []string{"1001", "1002"}) || len(result.Removed) != 0 {
+		t.Errorf("unexpected sync result %+#v", result)
+	}
+	if song := catalog.Songs["1001"]; len(song.Difficulties) != 3 || song.Licensed || song.Limited {
+		t.Errorf("expected difficulties merged across accounts, got %+#v", song)
+	}
+	if song := catalog.Songs["1002"]; !song.Licensed || !song.Limited {
+		t.Errorf("expected 1002 to be licensed and limited, got %+#v", song)
+	}
+	if jacket, err := catalog.Jacket(store, "1002"); err != nil || string(jacket) != "jacket-1002" {
+		t.Errorf("expected the stored jacket, got %q %v", jacket, err)
+	}
+
+	if _, err := SongCatalogForClients(nil, version, catalog, store); err == nil {
+		t.Errorf("expected an error without clients")
+	}
+	if catalog.Songs["1001"].Removed || catalog.Songs["1002"].Removed {
+		t.Errorf("expected no removals without clients")
+	}
+
+	if _, err := SongCatalogForClients([]util.EaClient{first, failing}, version, catalog, store); err == nil {
+		t.Errorf("expected an error when a client fails")
+	}
+	if catalog.Songs["1002"].Removed {
+		t.Errorf("expected no removals after a failed sync")
+	}
+
+	result, err = SongCatalogForClients([]util.EaClient{first}, version, catalog, store)
+	if err != nil {
+		t.Fatalf("unexpected error %s", err.Error())
+	}
+	if !reflect.DeepEqual(result.Removed, []string{"1002"}) || !catalog.Songs["1002"].Removed {
+		t.Errorf("expected 1002 to be removed, got %+#v", result)
+	}
+
+	result, err = SongCatalogForClients([]util.EaClient{second}, version, catalog, nil)
+	if err != nil || !reflect.DeepEqual(result.Restored, []string{"1002"}) {
+		t.Errorf("expected 1002 to be restored, got %+#v %v", result, err)
+	}
+
+	// Persist the catalog and load it back
+	var buffer bytes.Buffer
+	if err := catalog.Write(&buffer); err != nil {
+		t.Fatalf("unexpected error %s", err.Error())
+	}
+	loaded, err := ReadSongCatalog(&buffer)
+	if err != nil || len(loaded.Songs) != 2 || loaded.Songs["1002"].JacketHash != catalog.Songs["1002"].JacketHash {
+		t.Errorf("unexpected catalog after reload %+#v %v", loaded, err)
+	}
+}
+
+func TestSongCatalogForClientsWithoutJacketResource(t *testing.T) {
+	// Setup test
+	version := DefaultVersion()
+	pdataURI := util.BuildEaURI(version.pdataResource())
+	c, ts := testServerAndClient(map[string]string{pdataURI: "./test_data/pdata/music_data.json"})
+	defer ts.Close()
+
+	catalog := NewSongCatalog()
+	store := util.NewMemoryBlobStore()
+
+	// Run Test
+	if _, err := SongCatalogForClients([]util.EaClient{c}, version, catalog, store); err != nil {
+		t.Fatalf("unexpected error %s", err.Error())
+	}
+	if catalog.Songs["1001"].JacketHash != "" {
+		t.Errorf("expected no jacket to be loaded, got %s", catalog.Songs["1001"].JacketHash)
+	}
+}
@@ -0,0 +1,84 @@
+package drs
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Mode is the number of dancers a chart is for.
+type Mode int
+
+const (
+	Single Mode = iota
+	Double
+)
+
+var modeLabels = [...]string{
+	"Single",
+	"Double",
+}
+
+func (mode Mode) String() string {
+	if mode < 0 || int(mode) >= len(modeLabels) {
+		return ""
+	}
+	return modeLabels[mode]
+}
+
+// Difficulty is the difficulty of a chart, ordered from easiest.
+type Difficulty int
+
+const (
+	Easy Difficulty = iota
+	Normal
+)
+
+var difficultyLabels = [...]string{
+	"Easy",
+	"Normal",
+}
+
+func (difficulty Difficulty) String() string {
+	if difficulty < 0 || int(difficulty) >= len(difficultyLabels) {
+		return ""
+	}
+	return difficultyLabels[difficulty]
+}
+
+// musicTypeModes and musicTypeDifficulties map the two characters of a
+// MusicType code, such as "1a", to the chart they describe. Only the
+// codes seen in pdata responses are listed.
+var musicTypeModes = map[byte]Mode{
+	'1': Single,
+	'2': Double,
+}
+
+var musicTypeDifficulties = map[byte]Difficulty{
+	'a': Normal,
+	'b': Easy,
+}
+
+// MusicType is a decoded MusicType code.
+type MusicType struct {
+	Mode       Mode
+	Difficulty Difficulty
+}
+
+// ParseMusicType will decode a MusicType code, such as "1a", or a
+// difficulty key, such as "fumen_1a". Unknown codes are an error rather
+// than being guessed, so new charts are noticed.
+func ParseMusicType(code string) (musicType MusicType, err error) {
+	trimmed := strings.TrimPrefix(strings.ToLower(strings.TrimSpace(code)), "fumen_")
+	if len(trimmed) != 2 {
+		err = fmt.Errorf("music type %s is not recognised", code)
+		return
+	}
+	mode, modeOk := musicTypeModes[trimmed[0]]
+	difficulty, difficultyOk := musicTypeDifficulties[trimmed[1]]
+	if !modeOk || !difficultyOk {
+		err = fmt.Errorf("music type %s is not recognised", code)
+		return
+	}
+	musicType = MusicType{mode, difficulty}
+	return
+}
@@ -0,0 +1,30 @@
+package drs
+
+import (
+	"testing"
+)
+
+func TestParseMusicType(t *testing.T) {
+	testCases := map[string]MusicType{
+		"1a":       {Single, Normal},
+		"1b":       {Single, Easy},
+		"2b":       {Double, Easy},
+		"fumen_2a": {Double, Normal},
+	}
+	for code, expected := range testCases {
+		musicType, err := ParseMusicType(code)
+		if err != nil {
+			t.Errorf("unexpected error for %s: %s", code, err.Error())
+			continue
+		}
+		if musicType != expected {
+			t.Errorf("expected %+#v for %s, got %+#v", expected, code, musicType)
+		}
+	}
+
+	for _, code := range []string{"", "1", "1c", "1z", "3a", "1ab"} {
+		if _, err := ParseMusicType(code); err == nil {
+			t.Errorf("expected an error for %s", code)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package drs
+
+import (
+	"sort"
+	"time"
+
+	"github.com/chris-sg/eagate_models/drs_models"
+)
+
+// PartnerStats summarises the plays a dancer has shared with one
+// partner in two-player mode.
+type PartnerStats struct {
+	PartnerCode int
+	PlayCount   int
+	FirstPlayed time.Time
+	LastPlayed  time.Time
+	// FavouriteSongs is every song played together, most played first.
+	FavouriteSongs []PartnerSong
+
+	AverageCombinedScore float64
+	AveragePlayerScore   float64
+	AveragePartnerScore  float64
+
+	// PersonalBests are the charts where the dancer's best score was set
+	// with this partner.
+	PersonalBests []drs_models.PlayerSongStats
+}
+
+// PartnerSong is a song played together with a partner.
+type PartnerSong struct {
+	SongId    string
+	PlayCount int
+}
+
+// PartnerStatistics will group the two-player plays in scores and the
+// best scores in stats by the partner of playerCode. Partners are
+// ordered by play count, most played first. Solo plays and plays where
+// neither dancer is playerCode are ignored.
+func PartnerStatistics(playerCode int, scores []drs_models.PlayerScore, stats []drs_models.PlayerSongStats) (partners []PartnerStats) {
+	byPartner := make(map[int]*PartnerStats)
+	songCounts := make(map[int]map[string]int)
+	partnerFor := func(code int) *PartnerStats {
+		partner, ok := byPartner[code]
+		if !ok {
+			partner = &PartnerStats{PartnerCode: code}
+			byPartner[code] = partner
+			songCounts[code] = make(map[string]int)
+		}
+		return partner
+	}
+
+	type scoreTotals struct {
+		combined, player, partner int
+	}
+	totals := make(map[int]*scoreTotals)
+
+	for _, score := range scores {
+		if score.P2Code == nil {
+			continue
+		}
+		playerScore, partnerCode, partnerScore := score.P1Score, *score.P2Code, 0
+		if score.P2Score != nil {
+			partnerScore = *score.P2Score
+		}
+		if partnerCode == playerCode {
+			playerScore, partnerCode, partnerScore = partnerScore, score.P1Code, score.P1Score
+		} else if score.P1Code != playerCode {
+			continue
+		}
+
+		partner := partnerFor(partnerCode)
+		partner.PlayCount++
+		if partner.FirstPlayed.IsZero() || score.PlayTime.Before(partner.FirstPlayed) {
+			partner.FirstPlayed = score.PlayTime
+		}
+		if score.PlayTime.After(partner.LastPlayed) {
+			partner.LastPlayed = score.PlayTime
+		}
+		songCounts[partnerCode][score.SongId]++
+
+		total, ok := totals[partnerCode]
+		if !ok {
+			total = &scoreTotals{}
+			totals[partnerCode] = total
+		}
+		total.combined += score.Score
+		total.player += playerScore
+		total.partner += partnerScore
+	}
+
+	for _, stat := range stats {
+		if stat.P2Code == nil {
+			continue
+		}
+		partnerCode := *stat.P2Code
+		if partnerCode == playerCode {
+			partnerCode = stat.P1Code
+		} else if stat.P1Code != playerCode {
+			continue
+		}
+		partner := partnerFor(partnerCode)
+		partner.PersonalBests = append(partner.PersonalBests, stat)
+	}
+
+	for code, partner := range byPartner {
+		for songId, count := range songCounts[code] {
+			partner.FavouriteSongs = append(partner.FavouriteSongs, PartnerSong{songId, count})
+		}
+		sort.Slice(partner.FavouriteSongs, func(i, j int) bool {
+			a, b := partner.FavouriteSongs[i], partner.FavouriteSongs[j]
+			if a.PlayCount != b.PlayCount {
+				return a.PlayCount > b.PlayCount
+			}
+			return a.SongId < b.SongId
+		})
+		sort.Slice(partner.PersonalBests, func(i, j int) bool {
+			return partner.PersonalBests[i].BestScore > partner.PersonalBests[j].BestScore
+		})
+		if total, ok := totals[code]; ok && partner.PlayCount > 0 {
+			plays := float64(partner.PlayCount)
+			partner.AverageCombinedScore = float64(total.combined) / plays
+			partner.AveragePlayerScore = float64(total.player) / plays
+			partner.AveragePartnerScore = float64(total.partner) / plays
+		}
+		partners = append(partners, *partner)
+	}
+	sort.Slice(partners, func(i, j int) bool {
+		if partners[i].PlayCount != partners[j].PlayCount {
+			return partners[i].PlayCount > partners[j].PlayCount
+		}
+		return partners[i].PartnerCode < partners[j].PartnerCode
+	})
+	return
+}
@@ -0,0 +1,65 @@
+package drs
+
+import (
+	"github.com/chris-sg/eagate_models/drs_models"
+	"testing"
+	"time"
+)
+
+func TestPartnerStatistics(t *testing.T) {
+	// Setup test
+	const player = 100
+	partnerA, partnerB := 200, 300
+	played := time.Date(2020, time.May, 1, 12, 0, 0, 0, time.UTC)
+	duet := func(song string, p1 int, p1Score int, p2 int, p2Score int, minutes int) drs_models.PlayerScore {
+		return drs_models.PlayerScore{
+			SongId:   song,
+			Score:    p1Score + p2Score,
+			PlayTime: played.Add(time.Duration(minutes) * time.Minute),
+			P1Code:   p1,
+			P1Score:  p1Score,
+			P2Code:   &p2,
+			P2Score:  &p2Score,
+		}
+	}
+	scores := []drs_models.PlayerScore{
+		duet("a", player, 40000, partnerA, 30000, 0),
+		duet("a", partnerA, 20000, player, 50000, 5),
+		duet("b", player, 45000, partnerA, 35000, 10),
+		duet("c", player, 30000, partnerB, 30000, 15),
+		duet("e", partnerA, 30000, partnerB, 30000, 20),
+		{SongId: "d", Score: 90000, P1Code: player, P1Score: 90000, PlayTime: played},
+	}
+	stats := []drs_models.PlayerSongStats{
+		{SongId: "a", BestScore: 70000, P1Code: partnerA, P2Code: &[]int{player}[0]},
+		{SongId: "d", BestScore: 90000, P1Code: player},
+		{SongId: "e", BestScore: 60000, P1Code: partnerA, P2Code: &partnerB},
+	}
+
+	// Run Test
+	partners := PartnerStatistics(player, scores, stats)
+	if len(partners) != 2 {
+		t.Fatalf("expected 2 partners, got %+#v", partners)
+	}
+
+	a := partners[0]
+	if a.PartnerCode != partnerA || a.PlayCount != 3 {
+		t.Errorf("unexpected partner %+#v", a)
+	}
+	if !a.FirstPlayed.Equal(played) || !a.LastPlayed.Equal(played.Add(10*time.Minute)) {
+		t.Errorf("unexpected play times %v %v", a.FirstPlayed, a.LastPlayed)
+	}
+	if len(a.FavouriteSongs) != 2 || a.FavouriteSongs[0] != (PartnerSong{"a", 2}) {
+		t.Errorf("unexpected favourite songs %+#v", a.FavouriteSongs)
+	}
+	if a.AverageCombinedScore != 73333.33333333333 || a.AveragePlayerScore != 45000 {
+		t.Errorf("unexpected averages %f %f", a.AverageCombinedScore, a.AveragePlayerScore)
+	}
+	if len(a.PersonalBests) != 1 || a.PersonalBests[0].SongId != "a" {
+		t.Errorf("unexpected personal bests %+#v", a.PersonalBests)
+	}
+
+	if b := partners[1]; b.PartnerCode != partnerB || b.PlayCount != 1 || b.AveragePartnerScore != 30000 || len(b.PersonalBests) != 0 {
+		t.Errorf("unexpected partner %+#v", b)
+	}
+}
@@ -0,0 +1,186 @@
+package drs
+
+import (
+	"sort"
+	"time"
+
+	"github.com/chris-sg/eagate_models/drs_models"
+)
+
+// SessionOptions tunes how plays are grouped into sessions.
+type SessionOptions struct {
+	// SessionGap is the longest break between plays in one session.
+	SessionGap time.Duration
+	// EstimatedStageLength is added to the last play of a session when
+	// estimating its duration.
+	EstimatedStageLength time.Duration
+	// StagesPerCredit is the number of songs played for each credit.
+	StagesPerCredit int
+}
+
+// DefaultSessionOptions returns the options used for any field left at
+// zero.
+func DefaultSessionOptions() SessionOptions {
+	return SessionOptions{
+		SessionGap:           30 * time.Minute,
+		EstimatedStageLength: 2 * time.Minute,
+		StagesPerCredit:      2,
+	}
+}
+
+func (options SessionOptions) withDefaults() SessionOptions {
+	defaults := DefaultSessionOptions()
+	if options.SessionGap <= 0 {
+		options.SessionGap = defaults.SessionGap
+	}
+	if options.EstimatedStageLength <= 0 {
+		options.EstimatedStageLength = defaults.EstimatedStageLength
+	}
+	if options.StagesPerCredit <= 0 {
+		options.StagesPerCredit = defaults.StagesPerCredit
+	}
+	return options
+}
+
+// PlaySession is a run of plays at one shop without a long break.
+type PlaySession struct {
+	Shop     string
+	Start    time.Time
+	End      time.Time
+	Duration time.Duration
+
+	PlayCount        int
+	EstimatedCredits int
+	// Songs are the song ids played, in the order they were first played.
+	Songs        []string
+	Improvements []ScoreImprovement
+	Scores       []drs_models.PlayerScore
+}
+
+// ScoreImprovement is a play that beat the best earlier score on a chart.
+type ScoreImprovement struct {
+	SongId     string
+	Mode       string
+	Difficulty string
+	Previous   int
+	Score      int
+}
+
+// ShopVisits summarises the sessions played at a shop.
+type ShopVisits struct {
+	Shop       string
+	Sessions   int
+	PlayCount  int
+	FirstVisit time.Time
+	LastVisit  time.Time
+	// Monthly counts the sessions started in each month, oldest first.
+	Monthly []MonthlyVisits
+}
+
+// MonthlyVisits is the number of sessions at a shop in the month
+// starting at Month, in Japan time.
+type MonthlyVisits struct {
+	Month    time.Time
+	Sessions int
+}
+
+// PlaySessions will group plays into sessions, starting a new session
+// when the shop changes or after a break longer than the session gap.
+// Sessions are ordered oldest first. Improvements are measured against
+// earlier plays in scores only.
+func PlaySessions(scores []drs_models.PlayerScore, options SessionOptions) (sessions []PlaySession) {
+	options = options.withDefaults()
+
+	sorted := append([]drs_models.PlayerScore(nil), scores...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].PlayTime.Before(sorted[j].PlayTime)
+	})
+
+	best := make(map[string]int)
+	var session *PlaySession
+	songsSeen := make(map[string]bool)
+	for _, score := range sorted {
+		if session == nil || score.Shop != session.Shop || score.PlayTime.Sub(session.End) > options.SessionGap {
+			sessions = append(sessions, PlaySession{
+				Shop:  score.Shop,
+				Start: score.PlayTime,
+			})
+			session = &sessions[len(sessions)-1]
+			songsSeen = make(map[string]bool)
+		}
+		session.End = score.PlayTime
+		session.PlayCount++
+		session.Scores = append(session.Scores, score)
+		if !songsSeen[score.SongId] {
+			songsSeen[score.SongId] = true
+			session.Songs = append(session.Songs, score.SongId)
+		}
+
+		key := score.SongId + "|" + score.Mode + "|" + score.Difficulty
+		if previous, played := best[key]; played && score.Score > previous {
+			session.Improvements = append(session.Improvements, ScoreImprovement{
+				SongId:     score.SongId,
+				Mode:       score.Mode,
+				Difficulty: score.Difficulty,
+				Previous:   previous,
+				Score:      score.Score,
+			})
+		}
+		if previous, played := best[key]; !played || score.Score > previous {
+			best[key] = score.Score
+		}
+	}
+
+	for i := range sessions {
+		sessions[i].Duration = sessions[i].End.Sub(sessions[i].Start) + options.EstimatedStageLength
+		sessions[i].EstimatedCredits = (sessions[i].PlayCount + options.StagesPerCredit - 1) / options.StagesPerCredit
+	}
+	return
+}
+
+// ShopStatistics will summarise sessions by shop, most visited first.
+func ShopStatistics(sessions []PlaySession) (shops []ShopVisits) {
+	timeLocation, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		timeLocation = time.UTC
+	}
+
+	byShop := make(map[string]*ShopVisits)
+	monthly := make(map[string]map[time.Time]int)
+	for _, session := range sessions {
+		shop, ok := byShop[session.Shop]
+		if !ok {
+			shop = &ShopVisits{Shop: session.Shop, FirstVisit: session.Start}
+			byShop[session.Shop] = shop
+			monthly[session.Shop] = make(map[time.Time]int)
+		}
+		shop.Sessions++
+		shop.PlayCount += session.PlayCount
+		if session.Start.Before(shop.FirstVisit) {
+			shop.FirstVisit = session.Start
+		}
+		if session.Start.After(shop.LastVisit) {
+			shop.LastVisit = session.Start
+		}
+		start := session.Start.In(timeLocation)
+		month := time.Date(start.Year(), start.Month(), 1, 0, 0, 0, 0, timeLocation)
+		monthly[session.Shop][month]++
+	}
+
+	for name, shop := range byShop {
+		for month, count := range monthly[name] {
+			shop.Monthly = append(shop.Monthly, MonthlyVisits{month, count})
+		}
+		sort.Slice(shop.Monthly, func(i, j int) bool {
+			return shop.Monthly[i].Month.Before(shop.Monthly[j].Month)
+		})
+		shops = append(shops, *shop)
+	}
+	sort.Slice(shops, func(i, j int) bool {
+		if shops[i].Sessions != shops[j].Sessions {
+			return shops[i].Sessions > shops[j].Sessions
+		}
+		return shops[i].Shop < shops[j].Shop
+	})
+	return
+}
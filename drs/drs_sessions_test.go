@@ -0,0 +1,83 @@
+package drs
+
+import (
+	"github.com/chris-sg/eagate_models/drs_models"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestPlaySessions(t *testing.T) {
+	// Setup test
+	start := time.Date(2020, time.April, 30, 10, 0, 0, 0, time.UTC)
+	play := func(shop string, song string, score int, minutes int) drs_models.PlayerScore {
+		return drs_models.PlayerScore{
+			Shop:       shop,
+			SongId:     song,
+			Mode:       "Single",
+			Difficulty: "Normal",
+			Score:      score,
+			PlayTime:   start.Add(time.Duration(minutes) * time.Minute),
+		}
+	}
+	scores := []drs_models.PlayerScore{
+		play("SHOP A", "1001", 80000, 0),
+		play("SHOP A", "1002", 70000, 4),
+		play("SHOP A", "1001", 85000, 8),
+		// a break longer than the session gap
+		play("SHOP A", "1001", 82000, 60),
+		// a different shop, in the next month in Japan
+		play("SHOP B", "1001", 90000, 14*60+5),
+	}
+
+	// Run Test
+	sessions := PlaySessions(scores, DefaultSessionOptions())
+	if len(sessions) != 3 {
+		t.Fatalf("expected 3 sessions, got %d", len(sessions))
+	}
+
+	first := sessions[0]
+	if first.PlayCount != 3 || first.EstimatedCredits != 2 || first.Duration != 10*time.Minute {
+		t.Errorf("unexpected first session %+#v", first)
+	}
+	if !reflect.DeepEqual(first.Songs, []string{"1001", "1002"}) {
+		t.Errorf("unexpected songs %v", first.Songs)
+	}
+	if len(first.Improvements) != 1 || first.Improvements[0].Previous != 80000 || first.Improvements[0].Score != 85000 {
+		t.Errorf("unexpected improvements %+#v", first.Improvements)
+	}
+	if len(sessions[1].Improvements) != 0 || len(sessions[2].Improvements) != 1 {
+		t.Errorf("unexpected later improvements %+#v %+#v", sessions[1].Improvements, sessions[2].Improvements)
+	}
+
+	shops := ShopStatistics(sessions)
+	if len(shops) != 2 || shops[0].Shop != "SHOP A" || shops[0].Sessions != 2 || shops[0].PlayCount != 4 {
+		t.Fatalf("unexpected shops %+#v", shops)
+	}
+	if len(shops[0].Monthly) != 1 || shops[0].Monthly[0].Month.Month() != time.April {
+		t.Errorf("unexpected monthly visits %+#v", shops[0].Monthly)
+	}
+	if len(shops[1].Monthly) != 1 || shops[1].Monthly[0].Month.Month() != time.May {
+		t.Errorf("expected SHOP B to be visited in May in Japan, got %+#v", shops[1].Monthly)
+	}
+}
+
+func TestPlaySessionsWithOptions(t *testing.T) {
+	// Setup test
+	start := time.Date(2020, time.April, 30, 10, 0, 0, 0, time.UTC)
+	scores := []drs_models.PlayerScore{
+		{Shop: "SHOP A", SongId: "1001", PlayTime: start},
+		{Shop: "SHOP A", SongId: "1002", PlayTime: start.Add(10 * time.Minute)},
+		{Shop: "SHOP A", SongId: "1003", PlayTime: start.Add(20 * time.Minute)},
+	}
+	options := SessionOptions{SessionGap: 5 * time.Minute, StagesPerCredit: 3}
+
+	// Run Test
+	if sessions := PlaySessions(scores, options); len(sessions) != 3 || sessions[0].Duration != 2*time.Minute || sessions[0].EstimatedCredits != 1 {
+		t.Errorf("expected a session per play with a short gap, got %+#v", sessions)
+	}
+	options.SessionGap = 0
+	if sessions := PlaySessions(scores, options); len(sessions) != 1 || sessions[0].EstimatedCredits != 1 {
+		t.Errorf("expected the default gap to give one session, got %+#v", sessions)
+	}
+}
@@ -0,0 +1,136 @@
+package drs
+
+import (
+	"fmt"
+
+	"github.com/chris-sg/eagate_models/drs_models"
+)
+
+// Snapshot is the output of one Transform, kept together so it can be
+// compared with a later one.
+type Snapshot struct {
+	Player       drs_models.PlayerDetails         `json:"player"`
+	Profile      drs_models.PlayerProfileSnapshot `json:"profile"`
+	Songs        []drs_models.Song                `json:"songs"`
+	Difficulties []drs_models.Difficulty          `json:"difficulties"`
+	Stats        []drs_models.PlayerSongStats     `json:"stats"`
+	Scores       []drs_models.PlayerScore         `json:"scores"`
+}
+
+// TransformSnapshot transforms the DRS API responses of version into a
+// Snapshot, as with TransformForVersion.
+func TransformSnapshot(version Version, dancerInfo drs_models.DancerInfo, musicData drs_models.MusicData, playHist drs_models.PlayHist) (snapshot Snapshot, warnings []TransformWarning, err error) {
+	snapshot.Player, snapshot.Profile, snapshot.Songs, snapshot.Difficulties, snapshot.Stats, snapshot.Scores, warnings, err =
+		TransformForVersion(version, dancerInfo, musicData, playHist)
+	return
+}
+
+// StatsChange is a chart whose statistics changed between snapshots.
+type StatsChange struct {
+	Old drs_models.PlayerSongStats
+	New drs_models.PlayerSongStats
+}
+
+// ScoreImproved reports whether the best score went up.
+func (change StatsChange) ScoreImproved() bool {
+	return change.New.BestScore > change.Old.BestScore
+}
+
+// CounterChange is a profile counter that changed between snapshots.
+type CounterChange struct {
+	Field string
+	Old   int
+	New   int
+}
+
+// SnapshotDiff is everything that was added or changed between two
+// snapshots. Nothing is reported for data that was removed, since the
+// API only ever shows recent plays.
+type SnapshotDiff struct {
+	NewSongs        []drs_models.Song
+	NewDifficulties []drs_models.Difficulty
+	// NewStats are charts played for the first time.
+	NewStats     []drs_models.PlayerSongStats
+	ChangedStats []StatsChange
+	NewScores    []drs_models.PlayerScore
+	Profile      []CounterChange
+}
+
+// Empty reports whether nothing changed.
+func (diff SnapshotDiff) Empty() bool {
+	return len(diff.NewSongs) == 0 &&
+		len(diff.NewDifficulties) == 0 &&
+		len(diff.NewStats) == 0 &&
+		len(diff.ChangedStats) == 0 &&
+		len(diff.NewScores) == 0 &&
+		len(diff.Profile) == 0
+}
+
+// DiffSnapshots will compare an earlier snapshot with a later one,
+// keeping the order of the later snapshot.
+func DiffSnapshots(before Snapshot, after Snapshot) (diff SnapshotDiff) {
+	songs := make(map[string]bool)
+	for _, song := range before.Songs {
+		songs[song.SongId] = true
+	}
+	for _, song := range after.Songs {
+		if !songs[song.SongId] {
+			diff.NewSongs = append(diff.NewSongs, song)
+		}
+	}
+
+	difficulties := make(map[string]bool)
+	for _, difficulty := range before.Difficulties {
+		difficulties[snapshotChartKey(difficulty.SongId, difficulty.Mode, difficulty.Difficulty)] = true
+	}
+	for _, difficulty := range after.Difficulties {
+		if !difficulties[snapshotChartKey(difficulty.SongId, difficulty.Mode, difficulty.Difficulty)] {
+			diff.NewDifficulties = append(diff.NewDifficulties, difficulty)
+		}
+	}
+
+	stats := make(map[string]drs_models.PlayerSongStats)
+	for _, stat := range before.Stats {
+		stats[snapshotChartKey(stat.SongId, stat.Mode, stat.Difficulty)] = stat
+	}
+	for _, stat := range after.Stats {
+		previous, ok := stats[snapshotChartKey(stat.SongId, stat.Mode, stat.Difficulty)]
+		if !ok {
+			diff.NewStats = append(diff.NewStats, stat)
+		} else if !previous.Equals(stat) {
+			diff.ChangedStats = append(diff.ChangedStats, StatsChange{previous, stat})
+		}
+	}
+
+	scores := make(map[string]bool)
+	for _, score := range before.Scores {
+		scores[snapshotScoreKey(score)] = true
+	}
+	for _, score := range after.Scores {
+		if !scores[snapshotScoreKey(score)] {
+			diff.NewScores = append(diff.NewScores, score)
+		}
+	}
+
+	counters := []CounterChange{
+		{"PlayCount", before.Profile.PlayCount, after.Profile.PlayCount},
+		{"PlaySeconds", before.Profile.PlaySeconds, after.Profile.PlaySeconds},
+		{"TotalStars", before.Profile.TotalStars, after.Profile.TotalStars},
+		{"UsedStars", before.Profile.UsedStars, after.Profile.UsedStars},
+	}
+	for _, counter := range counters {
+		if counter.Old != counter.New {
+			diff.Profile = append(diff.Profile, counter)
+		}
+	}
+	return
+}
+
+func snapshotChartKey(songId string, mode string, difficulty string) string {
+	return songId + "|" + mode + "|" + difficulty
+}
+
+// snapshotScoreKey identifies a play across snapshots.
+func snapshotScoreKey(score drs_models.PlayerScore) string {
+	return fmt.Sprintf("%s|%d|%d", snapshotChartKey(score.SongId, score.Mode, score.Difficulty), score.PlayTime.UnixNano(), score.P1Code)
+}
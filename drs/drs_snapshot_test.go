@@ -0,0 +1,59 @@
+package drs
+
+import (
+	"github.com/chris-sg/eagate_models/drs_models"
+	"testing"
+	"time"
+)
+
+func TestDiffSnapshots(t *testing.T) {
+	// Setup test
+	played := time.Date(2020, time.May, 1, 12, 0, 0, 0, time.UTC)
+	before := Snapshot{
+		Profile:      drs_models.PlayerProfileSnapshot{PlayCount: 10, PlaySeconds: 1000, TotalStars: 50, UsedStars: 20},
+		Songs:        []drs_models.Song{{SongId: "1001"}},
+		Difficulties: []drs_models.Difficulty{{SongId: "1001", Mode: "Single", Difficulty: "Normal", Level: 7}},
+		Stats: []drs_models.PlayerSongStats{
+			{SongId: "1001", Mode: "Single", Difficulty: "Normal", BestScore: 80000, PlayCount: 2},
+		},
+		Scores: []drs_models.PlayerScore{
+			{SongId: "1001", Mode: "Single", Difficulty: "Normal", Score: 80000, PlayTime: played, P1Code: 1},
+		},
+	}
+	after := before
+	after.Profile.PlayCount = 12
+	after.Profile.PlaySeconds = 1200
+	after.Songs = append(after.Songs, drs_models.Song{SongId: "1002"})
+	after.Difficulties = append(after.Difficulties, drs_models.Difficulty{SongId: "1002", Mode: "Single", Difficulty: "Easy", Level: 3})
+	after.Stats = []drs_models.PlayerSongStats{
+		{SongId: "1001", Mode: "Single", Difficulty: "Normal", BestScore: 85000, PlayCount: 3},
+		{SongId: "1002", Mode: "Single", Difficulty: "Easy", BestScore: 90000, PlayCount: 1},
+	}
+	after.Scores = append(after.Scores,
+		drs_models.PlayerScore{SongId: "1001", Mode: "Single", Difficulty: "Normal", Score: 85000, PlayTime: played.Add(time.Minute), P1Code: 1},
+		drs_models.PlayerScore{SongId: "1002", Mode: "Single", Difficulty: "Easy", Score: 90000, PlayTime: played.Add(2 * time.Minute), P1Code: 1},
+	)
+
+	// Run Test
+	if diff := DiffSnapshots(before, before); !diff.Empty() {
+		t.Errorf("expected no changes, got %+#v", diff)
+	}
+
+	diff := DiffSnapshots(before, after)
+	if len(diff.NewSongs) != 1 || diff.NewSongs[0].SongId != "1002" || len(diff.NewDifficulties) != 1 {
+		t.Errorf("unexpected after songs %+#v %+#v", diff.NewSongs, diff.NewDifficulties)
+	}
+	if len(diff.NewStats) != 1 || diff.NewStats[0].SongId != "1002" {
+		t.Errorf("unexpected after stats %+#v", diff.NewStats)
+	}
+	if len(diff.ChangedStats) != 1 || !diff.ChangedStats[0].ScoreImproved() || diff.ChangedStats[0].Old.BestScore != 80000 {
+		t.Errorf("unexpected changed stats %+#v", diff.ChangedStats)
+	}
+	if len(diff.NewScores) != 2 {
+		t.Errorf("expected 2 after scores, got %+#v", diff.NewScores)
+	}
+	expectedProfile := []CounterChange{{"PlayCount", 10, 12}, {"PlaySeconds", 1000, 1200}}
+	if len(diff.Profile) != 2 || diff.Profile[0] != expectedProfile[0] || diff.Profile[1] != expectedProfile[1] {
+		t.Errorf("unexpected profile changes %+#v", diff.Profile)
+	}
+}
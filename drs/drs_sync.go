@@ -0,0 +1,104 @@
+package drs
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/chris-sg/eagate/util"
+	"github.com/chris-sg/eagate_models/drs_models"
+	"github.com/golang/glog"
+)
+
+// ProfileBundleSchema is the layout version of ProfileBundle, raised when
+// the bundle changes in a way that stored bundles need migrating.
+const ProfileBundleSchema = 1
+
+var (
+	// ErrMaintenance is returned when e-amusement is in maintenance.
+	ErrMaintenance = errors.New("e-amusement is in maintenance")
+	// ErrNotLoggedIn is returned when the client's login has expired.
+	ErrNotLoggedIn = errors.New("client is not logged in")
+)
+
+// ProfileBundle is everything loaded for a dancer by SyncProfile.
+type ProfileBundle struct {
+	Schema    int                `json:"schema"`
+	Version   string             `json:"version"`
+	FetchedAt time.Time          `json:"fetchedat"`
+	Snapshot  Snapshot           `json:"snapshot"`
+	Warnings  []TransformWarning `json:"warnings"`
+}
+
+// SyncProfile will check the client can load data, load the dancer
+// info, music data and play history of version concurrently and
+// transform them into a single bundle. ErrMaintenance and ErrNotLoggedIn
+// are returned when loading is not possible, including when the login
+// expires part way through.
+func SyncProfile(client util.EaClient, version Version) (bundle ProfileBundle, err error) {
+	if util.IsMaintenanceMode(client) {
+		err = ErrMaintenance
+		return
+	}
+	if !client.LoginState() {
+		err = ErrNotLoggedIn
+		return
+	}
+
+	var dancerInfo drs_models.DancerInfo
+	var musicData drs_models.MusicData
+	var playHist drs_models.PlayHist
+	loaders := []func() error{
+		func() (err error) {
+			dancerInfo, err = LoadDancerInfoForVersion(client, version)
+			return
+		},
+		func() (err error) {
+			musicData, err = LoadMusicDataForVersion(client, version)
+			return
+		},
+		func() (err error) {
+			playHist, err = LoadPlayHistForVersion(client, version)
+			return
+		},
+	}
+
+	fetchedAt := time.Now()
+	mtx := &sync.Mutex{}
+
+	wg := new(sync.WaitGroup)
+	wg.Add(len(loaders))
+
+	errCount := 0
+
+	for _, l := range loaders {
+		go func(load func() error) {
+			defer wg.Done()
+			err := load()
+			if err != nil {
+				glog.Errorf("failed to load drs data for user %s: %s\n", client.GetUsername(), err.Error())
+				mtx.Lock()
+				errCount++
+				mtx.Unlock()
+			}
+		}(l)
+	}
+
+	wg.Wait()
+
+	if errCount > 0 {
+		if !client.LoginState() {
+			err = ErrNotLoggedIn
+			return
+		}
+		err = fmt.Errorf("failed to load %d/%d drs data kinds", errCount, len(loaders))
+		return
+	}
+
+	bundle.Schema = ProfileBundleSchema
+	bundle.Version = version.Id
+	bundle.FetchedAt = fetchedAt
+	bundle.Snapshot, bundle.Warnings, err = TransformSnapshot(version, dancerInfo, musicData, playHist)
+	return
+}
@@ -0,0 +1,135 @@
+package drs
+
+import (
+	"bytes"
+	"github.com/chris-sg/eagate/util"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"testing"
+)
+
+// syncTestTransport serves pdata_getdata by pdata_kind, and other
+// resources by URL.
+type syncTestTransport struct {
+	pdata     map[string]string
+	resources map[string]string
+}
+
+func (transport syncTestTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	file, ok := transport.resources[req.URL.String()]
+	if req.Method == http.MethodPost {
+		req.ParseForm()
+		file, ok = transport.pdata[req.PostForm.Get("pdata_kind")]
+	}
+	status, body := http.StatusBadRequest, []byte("bad")
+	if ok {
+		status = http.StatusOK
+		body, _ = ioutil.ReadFile(file)
+	}
+	return &http.Response{
+		StatusCode: status,
+		Body:       ioutil.NopCloser(bytes.NewReader(body)),
+		Header:     http.Header{},
+	}, nil
+}
+
+func syncTestClient(pdata map[string]string, game string, loggedIn bool) util.EaClient {
+	resources := map[string]string{"https://p.eagate.573.jp/game/": game}
+	if loggedIn {
+		resources["https://p.eagate.573.jp/gate/p/mypage/index.html"] = game
+	}
+	client := util.GenerateClient()
+	client.Client.Transport = syncTestTransport{pdata, resources}
+	return client
+}
+
+// expiringLoginTransport serves the login check for the first
+// loginChecks requests to it only, as if the login expired after that.
+type expiringLoginTransport struct {
+	syncTestTransport
+
+	lk          *sync.Mutex
+	loginChecks *int
+}
+
+func (transport expiringLoginTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.URL.String() == "https://p.eagate.573.jp/gate/p/mypage/index.html" {
+		transport.lk.Lock()
+		defer transport.lk.Unlock()
+		if *transport.loginChecks == 0 {
+			return &http.Response{
+				StatusCode: http.StatusBadRequest,
+				Body:       ioutil.NopCloser(bytes.NewReader([]byte("bad"))),
+				Header:     http.Header{},
+			}, nil
+		}
+		*transport.loginChecks--
+	}
+	return transport.syncTestTransport.RoundTrip(req)
+}
+
+func TestSyncProfile(t *testing.T) {
+	// Setup test
+	pdata := map[string]string{
+		"dancer_info": "./test_data/pdata/dancer_info.json",
+		"music_data":  "./test_data/pdata/music_data.json",
+		"play_hist":   "./test_data/pdata/play_hist.json",
+	}
+	client := syncTestClient(pdata, "./test_data/sync/game.html", true)
+
+	// Run Test
+	bundle, err := SyncProfile(client, DefaultVersion())
+	if err != nil {
+		t.Fatalf("unexpected error %s", err.Error())
+	}
+	if bundle.Schema != ProfileBundleSchema || bundle.Version != "1st" || bundle.FetchedAt.IsZero() {
+		t.Errorf("unexpected bundle header %+#v", bundle)
+	}
+	if bundle.Snapshot.Player.Name != "DANCER" || len(bundle.Snapshot.Scores) != 2 || len(bundle.Warnings) == 0 {
+		t.Errorf("unexpected bundle contents %+#v", bundle)
+	}
+}
+
+func TestSyncProfileErrors(t *testing.T) {
+	// Setup test
+	pdata := map[string]string{
+		"dancer_info": "./test_data/pdata/dancer_info.json",
+		"music_data":  "./test_data/pdata/music_data.json",
+	}
+
+	// Run Test
+	if _, err := SyncProfile(syncTestClient(pdata, "./test_data/sync/maintenance.html", true), DefaultVersion()); err != ErrMaintenance {
+		t.Errorf("expected ErrMaintenance, got %v", err)
+	}
+	if _, err := SyncProfile(syncTestClient(pdata, "./test_data/sync/game.html", false), DefaultVersion()); err != ErrNotLoggedIn {
+		t.Errorf("expected ErrNotLoggedIn, got %v", err)
+	}
+	_, err := SyncProfile(syncTestClient(pdata, "./test_data/sync/game.html", true), DefaultVersion())
+	if err == nil || err == ErrNotLoggedIn || err == ErrMaintenance {
+		t.Errorf("expected a load error for the missing play history, got %v", err)
+	}
+}
+
+func TestSyncProfileLoginExpired(t *testing.T) {
+	// Setup test
+	pdata := map[string]string{
+		"dancer_info": "./test_data/pdata/dancer_info.json",
+		"music_data":  "./test_data/pdata/music_data.json",
+	}
+	client := syncTestClient(pdata, "./test_data/sync/game.html", true)
+	loginChecks := 1
+	client.Client.Transport = expiringLoginTransport{
+		syncTestTransport: client.Client.Transport.(syncTestTransport),
+		lk:                &sync.Mutex{},
+		loginChecks:       &loginChecks,
+	}
+
+	// Run Test
+	if _, err := SyncProfile(client, DefaultVersion()); err != ErrNotLoggedIn {
+		t.Errorf("expected ErrNotLoggedIn when the login expires during loading, got %v", err)
+	}
+	if loginChecks != 0 {
+		t.Errorf("expected the login to have been checked before loading")
+	}
+}
@@ -1,83 +1,63 @@
 package drs
 
 import (
+	"fmt"
 	"github.com/chris-sg/eagate_models/drs_models"
-	"github.com/golang/glog"
 	"strings"
 	"time"
 )
 
-func Transform(dancerInfo drs_models.DancerInfo, musicData drs_models.MusicData, playHist drs_models.PlayHist) (pd drs_models.PlayerDetails, pps drs_models.PlayerProfileSnapshot, s []drs_models.Song, d []drs_models.Difficulty, pss []drs_models.PlayerSongStats, ps []drs_models.PlayerScore) {
+// Transform converts the DRS API responses of the default version into
+// drs_models types. An error is returned if the responses cannot be
+// transformed at all, and any data that was transformed with problems is
+// described by warnings.
+func Transform(dancerInfo drs_models.DancerInfo, musicData drs_models.MusicData, playHist drs_models.PlayHist) (pd drs_models.PlayerDetails, pps drs_models.PlayerProfileSnapshot, s []drs_models.Song, d []drs_models.Difficulty, pss []drs_models.PlayerSongStats, ps []drs_models.PlayerScore, warnings []TransformWarning, err error) {
+	return TransformForVersion(DefaultVersion(), dancerInfo, musicData, playHist)
+}
+
+// TransformForVersion converts the DRS API responses of version into
+// drs_models types, as with Transform.
+func TransformForVersion(version Version, dancerInfo drs_models.DancerInfo, musicData drs_models.MusicData, playHist drs_models.PlayHist) (pd drs_models.PlayerDetails, pps drs_models.PlayerProfileSnapshot, s []drs_models.Song, d []drs_models.Difficulty, pss []drs_models.PlayerSongStats, ps []drs_models.PlayerScore, warnings []TransformWarning, err error) {
+	warnings, err = validateSources(dancerInfo, musicData, playHist)
+	if err != nil {
+		return
+	}
+	now := time.Now()
+	playerCode := musicData.Data.PlayerData.UserId.Code
+
 	pd = drs_models.PlayerDetails{
 		Code:       musicData.Data.PlayerData.UserId.Code,
 		Name:       dancerInfo.Data.EaSite.Profile.Name,
 		EaGateUser: nil,
 	}
-	
+
 	pps = drs_models.PlayerProfileSnapshot{
 		PlayCount:   dancerInfo.Data.EaSite.Statistics.PlayCount,
 		PlaySeconds: dancerInfo.Data.EaSite.Statistics.PlaySecs,
 		TotalStars:  dancerInfo.Data.EaSite.Coins.Total,
 		UsedStars:   dancerInfo.Data.EaSite.Coins.Used,
-		LastPlayed:  time.Unix(0, playHist.Data.PlayerData.MusicHistory.Music[0].LastPlayDate*1000000),
-		PlayerCode:  musicData.Data.PlayerData.UserId.Code,
+		PlayerCode:  playerCode,
 	}
-	
-	for songId, songDetails := range musicData.Data.PlayerData.MusicDb {
-		song := drs_models.Song{
-			SongId:         songId,
-			SongName:       songDetails.Info.TitleName,
-			ArtistName:     songDetails.Info.ArtistName,
-			MaxBpm:         songDetails.Info.BpmMax,
-			MinBpm:         songDetails.Info.BpmMin,
-			LimitationType: songDetails.Info.LimitationType,
-			Genre:          songDetails.Info.Genre,
-			VideoFlags:     songDetails.Info.PlayVideoFlags,
-			License:        songDetails.Info.License,
-		}
-		s = append(s, song)
-		for diffType, rawDiff := range songDetails.Difficulties {
-			if !strings.HasPrefix(diffType, "fumen_") {
-				glog.Warningf("diff field for %s does not have prefix, instead %s\n", songId, diffType)
-				continue
-			}
-			diffType = diffType[len("fumen_"):]
-			mode := "Double"
-			if strings.HasPrefix(diffType, "1") {
-				mode = "Single"
-			}
-			difficulty := "Easy"
-			if strings.HasSuffix(diffType, "a") {
-				difficulty = "Normal"
-			}
-
-			diff := drs_models.Difficulty{
-				Mode:        mode,
-				Difficulty: difficulty,
-				Level:      rawDiff.DiffNum,
-				SongId:     songId,
-			}
-			d = append(d, diff)
-		}
+	if history := playHist.Data.PlayerData.MusicHistory.Music; len(history) > 0 {
+		pps.LastPlayed = timeFromApi(version, history[0].LastPlayDate, history[0].MusicId, "last played", now, &warnings)
 	}
 
+	s, d = songsFromMusicData(version, musicData, &warnings)
+
 	for _, chart := range musicData.Data.PlayerData.ScoreData.Music {
-		mode := "Double"
-		if strings.HasPrefix(chart.MusicType, "1") {
-			mode = "Single"
-		}
-		difficulty := "Easy"
-		if strings.HasSuffix(chart.MusicType, "a") {
-			difficulty = "Normal"
+		mode, difficulty, ok := modeAndDifficulty(version, chart.MusicType)
+		if !ok {
+			warnings = append(warnings, unknownMusicTypeWarning(chart.MusicId, chart.MusicType))
+			continue
 		}
-		
+
 		stat := drs_models.PlayerSongStats{
 			BestScore:         chart.Score,
 			Combo:             chart.Combo,
 			PlayCount:         chart.PlayCount,
 			Param:             chart.Param,
-			BestScoreDateTime: time.Unix(0, chart.BestScoreDate*1000000),
-			LastPlayDateTime:  time.Unix(0, chart.LastPlayDate*1000000),
+			BestScoreDateTime: timeFromApi(version, chart.BestScoreDate, chart.MusicId, "best score date", now, &warnings),
+			LastPlayDateTime:  timeFromApi(version, chart.LastPlayDate, chart.MusicId, "last play date", now, &warnings),
 			P1Code:            chart.Player1.Code,
 			P1Score:           chart.Player1.Score,
 			P1Perfects:        chart.Player1.Perfect,
@@ -90,12 +70,12 @@ func Transform(dancerInfo drs_models.DancerInfo, musicData drs_models.MusicData,
 			P2Greats:          nil,
 			P2Goods:           nil,
 			P2Bads:            nil,
-			PlayerCode:        musicData.Data.PlayerData.UserId.Code,
+			PlayerCode:        playerCode,
 			SongId:            chart.MusicId,
 			Mode:              mode,
 			Difficulty:        difficulty,
 		}
-		
+
 		if chart.Player2 != nil {
 			stat.P2Code = &chart.Player2.Code
 			stat.P2Score = &chart.Player2.Score
@@ -104,18 +84,16 @@ func Transform(dancerInfo drs_models.DancerInfo, musicData drs_models.MusicData,
 			stat.P2Goods = &chart.Player2.Good
 			stat.P2Bads = &chart.Player2.Bad
 		}
-		
+		checkPlayerCodes(playerCode, chart.MusicId, stat.P1Code, stat.P2Code, &warnings)
+
 		pss = append(pss, stat)
 	}
-	
+
 	for _, score := range playHist.Data.PlayerData.MusicHistory.Music {
-		mode := "Double"
-		if strings.HasPrefix(score.MusicType, "1") {
-			mode = "Single"
-		}
-		difficulty := "Easy"
-		if strings.HasSuffix(score.MusicType, "a") {
-			difficulty = "Normal"
+		mode, difficulty, ok := modeAndDifficulty(version, score.MusicType)
+		if !ok {
+			warnings = append(warnings, unknownMusicTypeWarning(score.MusicId, score.MusicType))
+			continue
 		}
 
 		recentScore := drs_models.PlayerScore{
@@ -123,7 +101,7 @@ func Transform(dancerInfo drs_models.DancerInfo, musicData drs_models.MusicData,
 			Score:      score.Score,
 			MaxCombo:   score.Combo,
 			Param:      score.Param,
-			PlayTime:   time.Unix(0, score.LastPlayDate*1000000),
+			PlayTime:   timeFromApi(version, score.LastPlayDate, score.MusicId, "play time", now, &warnings),
 			P1Code:     score.Player1.PlayerCode,
 			P1Score:    score.Player1.MemberScore,
 			P1Perfects: score.Player1.Perfect,
@@ -137,7 +115,7 @@ func Transform(dancerInfo drs_models.DancerInfo, musicData drs_models.MusicData,
 			P2Goods:    nil,
 			P2Bads:     nil,
 			VideoUrl:   nil,
-			PlayerCode: musicData.Data.PlayerData.UserId.Code,
+			PlayerCode: playerCode,
 			SongId:     score.MusicId,
 			Mode:       mode,
 			Difficulty: difficulty,
@@ -153,11 +131,60 @@ func Transform(dancerInfo drs_models.DancerInfo, musicData drs_models.MusicData,
 		}
 
 		if len(score.VideoUrl) > 0 {
-			recentScore.VideoUrl = &score.VideoUrl
+			videoUrl := score.VideoUrl
+			recentScore.VideoUrl = &videoUrl
 		}
+		checkPlayerCodes(playerCode, score.MusicId, recentScore.P1Code, recentScore.P2Code, &warnings)
 
 		ps = append(ps, recentScore)
 	}
 
 	return
 }
+
+func unknownMusicTypeWarning(songId string, musicType string) TransformWarning {
+	return TransformWarning{
+		Kind:    UnknownMusicType,
+		SongId:  songId,
+		Message: fmt.Sprintf("music type %s is not recognised", musicType),
+	}
+}
+
+// songsFromMusicData builds the songs and difficulties in the music
+// database of a music_data response.
+func songsFromMusicData(version Version, musicData drs_models.MusicData, warnings *[]TransformWarning) (s []drs_models.Song, d []drs_models.Difficulty) {
+	for songId, songDetails := range musicData.Data.PlayerData.MusicDb {
+		song := drs_models.Song{
+			SongId:         songId,
+			SongName:       songDetails.Info.TitleName,
+			ArtistName:     songDetails.Info.ArtistName,
+			MaxBpm:         songDetails.Info.BpmMax,
+			MinBpm:         songDetails.Info.BpmMin,
+			LimitationType: songDetails.Info.LimitationType,
+			Genre:          songDetails.Info.Genre,
+			VideoFlags:     songDetails.Info.PlayVideoFlags,
+			License:        songDetails.Info.License,
+		}
+		s = append(s, song)
+		for diffType, rawDiff := range songDetails.Difficulties {
+			mode, difficulty, ok := modeAndDifficulty(version, strings.TrimPrefix(diffType, version.DifficultyKeyPrefix))
+			if !strings.HasPrefix(diffType, version.DifficultyKeyPrefix) || !ok {
+				*warnings = append(*warnings, TransformWarning{
+					Kind:    UnknownDifficultyKey,
+					SongId:  songId,
+					Message: fmt.Sprintf("difficulty key %s is not recognised", diffType),
+				})
+				continue
+			}
+
+			diff := drs_models.Difficulty{
+				Mode:       mode,
+				Difficulty: difficulty,
+				Level:      rawDiff.DiffNum,
+				SongId:     songId,
+			}
+			d = append(d, diff)
+		}
+	}
+	return
+}
@@ -0,0 +1,85 @@
+package drs
+
+import (
+	"encoding/json"
+	"github.com/chris-sg/eagate_models/drs_models"
+	"io/ioutil"
+	"testing"
+)
+
+func pdataFromFile(t *testing.T, file string, v interface{}) {
+	body, err := ioutil.ReadFile(file)
+	if err != nil {
+		t.Fatalf("could not read %s: %s", file, err.Error())
+	}
+	if err = json.Unmarshal(body, v); err != nil {
+		t.Fatalf("could not decode %s: %s", file, err.Error())
+	}
+}
+
+func TestTransform(t *testing.T) {
+	// Setup test
+	var dancerInfo drs_models.DancerInfo
+	var musicData drs_models.MusicData
+	var playHist drs_models.PlayHist
+	pdataFromFile(t, "./test_data/pdata/dancer_info.json", &dancerInfo)
+	pdataFromFile(t, "./test_data/pdata/music_data.json", &musicData)
+	pdataFromFile(t, "./test_data/pdata/play_hist.json", &playHist)
+
+	// Setup expected results
+	expectedWarnings := map[TransformWarningKind]int{
+		UnknownDifficultyKey: 1,
+		UnknownMusicType:     1,
+		TimestampOutOfRange:  1,
+		PlayerCodeMismatch:   1,
+	}
+
+	// Run Test
+	pd, pps, songs, difficulties, stats, scores, warnings, err := Transform(dancerInfo, musicData, playHist)
+	if err != nil {
+		t.Fatalf("unexpected error %s", err.Error())
+	}
+	if pd.Code != 12345678 || pd.Name != "DANCER" || pps.LastPlayed.IsZero() {
+		t.Errorf("unexpected player %+#v %+#v", pd, pps)
+	}
+	if len(songs) != 1 || len(difficulties) != 2 || len(stats) != 2 || len(scores) != 2 {
+		t.Errorf("unexpected counts %d songs, %d difficulties, %d stats, %d scores", len(songs), len(difficulties), len(stats), len(scores))
+	}
+	if *scores[0].VideoUrl == *scores[1].VideoUrl {
+		t.Errorf("expected each score to keep its own video url")
+	}
+
+	kinds := make(map[TransformWarningKind]int)
+	for _, warning := range warnings {
+		kinds[warning.Kind]++
+	}
+	for kind, count := range expectedWarnings {
+		if kinds[kind] != count {
+			t.Errorf("expected %d %s warnings, got %v", count, kind.String(), warnings)
+		}
+	}
+}
+
+func TestTransformEmptyHistory(t *testing.T) {
+	// Setup test
+	var musicData drs_models.MusicData
+	var playHist drs_models.PlayHist
+	pdataFromFile(t, "./test_data/pdata/music_data.json", &musicData)
+	pdataFromFile(t, "./test_data/pdata/play_hist_empty.json", &playHist)
+
+	// Run Test
+	_, pps, _, _, _, scores, warnings, err := Transform(drs_models.DancerInfo{}, musicData, playHist)
+	if err != nil {
+		t.Fatalf("unexpected error %s", err.Error())
+	}
+	if !pps.LastPlayed.IsZero() || len(scores) != 0 {
+		t.Errorf("expected no last played time or scores, got %v %d", pps.LastPlayed, len(scores))
+	}
+	if len(warnings) == 0 || warnings[0].Kind != EmptyPlayHistory {
+		t.Errorf("expected an empty play history warning, got %v", warnings)
+	}
+
+	if _, _, _, _, _, _, _, err := Transform(drs_models.DancerInfo{}, drs_models.MusicData{}, playHist); err == nil {
+		t.Errorf("expected an error without a player code")
+	}
+}
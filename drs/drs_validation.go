@@ -0,0 +1,114 @@
+package drs
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/chris-sg/eagate_models/drs_models"
+)
+
+// TransformWarningKind categorises problems found while transforming
+// DRS API data.
+type TransformWarningKind int
+
+const (
+	EmptyPlayHistory TransformWarningKind = iota
+	UnknownMusicType
+	UnknownDifficultyKey
+	PlayerCodeMismatch
+	TimestampOutOfRange
+)
+
+var transformWarningKindLabels = [...]string{
+	"EMPTY PLAY HISTORY",
+	"UNKNOWN MUSIC TYPE",
+	"UNKNOWN DIFFICULTY KEY",
+	"PLAYER CODE MISMATCH",
+	"TIMESTAMP OUT OF RANGE",
+}
+
+func (kind TransformWarningKind) String() string {
+	if kind < 0 || int(kind) >= len(transformWarningKindLabels) {
+		return ""
+	}
+	return transformWarningKindLabels[kind]
+}
+
+// TransformWarning is a problem with the DRS API data that did not stop
+// it from being transformed. SongId is empty when the warning is not
+// about a single song.
+type TransformWarning struct {
+	Kind    TransformWarningKind
+	SongId  string
+	Message string
+}
+
+func (w TransformWarning) String() string {
+	if w.SongId == "" {
+		return fmt.Sprintf("%s: %s", w.Kind.String(), w.Message)
+	}
+	return fmt.Sprintf("%s: %s: %s", w.Kind.String(), w.SongId, w.Message)
+}
+
+// drsEpoch is before the first DANCERUSH STARDOM release. Any earlier
+// timestamp is a decoding problem.
+var drsEpoch = time.Date(2018, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// modeAndDifficulty converts a MusicType code, such as "1a", into the
+// mode and difficulty labels used by drs_models.
+func modeAndDifficulty(version Version, code string) (mode string, difficulty string, ok bool) {
+	musicType, err := version.ParseMusicType(code)
+	if err != nil {
+		return
+	}
+	return musicType.Mode.String(), musicType.Difficulty.String(), true
+}
+
+// timeFromApi converts an API timestamp, warning when it falls outside
+// of the DRS lifetime.
+func timeFromApi(version Version, timestamp int64, songId string, field string, now time.Time, warnings *[]TransformWarning) time.Time {
+	t := version.timeFromApi(timestamp)
+	if t.Before(drsEpoch) || t.After(now.Add(24*time.Hour)) {
+		*warnings = append(*warnings, TransformWarning{
+			Kind:    TimestampOutOfRange,
+			SongId:  songId,
+			Message: fmt.Sprintf("%s %d is out of range", field, timestamp),
+		})
+	}
+	return t
+}
+
+// checkPlayerCodes warns when a play does not include the player.
+func checkPlayerCodes(playerCode int, songId string, p1 int, p2 *int, warnings *[]TransformWarning) {
+	if p1 == playerCode || (p2 != nil && *p2 == playerCode) {
+		return
+	}
+	*warnings = append(*warnings, TransformWarning{
+		Kind:    PlayerCodeMismatch,
+		SongId:  songId,
+		Message: fmt.Sprintf("play by %d does not include player %d", p1, playerCode),
+	})
+}
+
+// validateSources checks the API responses agree with each other before
+// they are transformed.
+func validateSources(dancerInfo drs_models.DancerInfo, musicData drs_models.MusicData, playHist drs_models.PlayHist) (warnings []TransformWarning, err error) {
+	playerCode := musicData.Data.PlayerData.UserId.Code
+	if playerCode == 0 {
+		err = fmt.Errorf("music data does not contain a player code")
+		return
+	}
+	if historyCode := playHist.Data.PlayerData.UserId.Code; historyCode != 0 && historyCode != playerCode {
+		warnings = append(warnings, TransformWarning{
+			Kind:    PlayerCodeMismatch,
+			Message: fmt.Sprintf("play history is for player %d, music data is for player %d", historyCode, playerCode),
+		})
+	}
+	if len(playHist.Data.PlayerData.MusicHistory.Music) == 0 {
+		warnings = append(warnings, TransformWarning{
+			Kind:    EmptyPlayHistory,
+			Message: "play history is empty, last played time is unknown",
+		})
+	}
+	return
+}
@@ -0,0 +1,92 @@
+package drs
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/chris-sg/eagate_models/drs_models"
+	"testing"
+	"time"
+)
+
+func TestVersionRegistry(t *testing.T) {
+	// Setup test
+	version := Version{
+		Id:                    "test",
+		Name:                  "TEST",
+		TimestampUnit:         time.Second,
+		DifficultyKeyPrefix:   "chart_",
+		MusicTypeDifficulties: map[byte]Difficulty{'x': Normal},
+	}
+
+	// Run Test
+	if err := RegisterVersion(version); err != nil {
+		t.Fatalf("unexpected error %s", err.Error())
+	}
+	defer UnregisterVersion(version.Id)
+	found, err := LookupVersion("test")
+	if err != nil || found.Name != "TEST" {
+		t.Errorf("expected to find the test version, got %+#v %v", found, err)
+	}
+	if _, err := LookupVersion("missing"); err == nil {
+		t.Errorf("expected an error for an unknown version")
+	}
+	if DefaultVersion().pdataResource() != "/game/dan/1st/json/pdata_getdata.html" {
+		t.Errorf("unexpected default resource %s", DefaultVersion().pdataResource())
+	}
+	if err := RegisterVersion(Version{}); err == nil {
+		t.Errorf("expected an error for a version without an id")
+	}
+
+	if musicType, err := version.ParseMusicType("2x"); err != nil || musicType != (MusicType{Double, Normal}) {
+		t.Errorf("expected 2x to be double normal, got %+#v %v", musicType, err)
+	}
+	if _, err := DefaultVersion().ParseMusicType("2x"); err == nil {
+		t.Errorf("expected 2x to be unknown to the default version")
+	}
+	if played := version.timeFromApi(1588000000); !played.Equal(time.Unix(1588000000, 0)) {
+		t.Errorf("expected timestamps in seconds, got %v", played)
+	}
+}
+
+func TestUnregisterVersion(t *testing.T) {
+	// Setup test
+	if err := RegisterVersion(Version{Id: "removed"}); err != nil {
+		t.Fatalf("unexpected error %s", err.Error())
+	}
+
+	// Run Test
+	if err := UnregisterVersion("removed"); err != nil {
+		t.Fatalf("unexpected error %s", err.Error())
+	}
+	if _, err := LookupVersion("removed"); err == nil {
+		t.Errorf("expected the version to be removed")
+	}
+	if err := UnregisterVersion(DefaultVersionId); err == nil {
+		t.Errorf("expected an error removing the default version")
+	}
+	if len(Versions()) != 1 {
+		t.Errorf("expected only the default version to remain, got %+#v", Versions())
+	}
+}
+
+func TestVersionDecode(t *testing.T) {
+	// Setup test
+	decoded := false
+	version := Version{
+		Id: "renamed",
+		Decode: func(kind PdataKind, body []byte, v interface{}) error {
+			decoded = true
+			return json.Unmarshal(bytes.Replace(body, []byte(`"player_name"`), []byte(`"name"`), -1), v)
+		},
+	}
+	body := []byte(`{"status":0,"data":{"status":0,"easite_get_playerdata":{"result":"0","profile":{"player_name":"DANCER"}}}}`)
+
+	// Run Test
+	var dancerInfo drs_models.DancerInfo
+	if err := pdataFromBytes(version, DancerInfoKind, body, &dancerInfo); err != nil {
+		t.Fatalf("unexpected error %s", err.Error())
+	}
+	if !decoded || dancerInfo.Data.EaSite.Profile.Name != "DANCER" {
+		t.Errorf("expected the version decoder to be used, got %+#v", dancerInfo)
+	}
+}
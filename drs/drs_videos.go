@@ -0,0 +1,362 @@
+package drs
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/chris-sg/eagate/util"
+	"github.com/chris-sg/eagate_models/drs_models"
+	"github.com/golang/glog"
+)
+
+const (
+	videoKeyPrefix = "drs/videos/"
+	// partialVideoSuffix is added to a video key to give the prefix of
+	// the chunks of its partial download.
+	partialVideoSuffix = ".part/"
+	// videoChunkSize is how much of a video is downloaded into each
+	// stored chunk of a partial download.
+	videoChunkSize = 1 << 20
+)
+
+// VideoRetention limits how many archived videos are kept. Zero values
+// keep everything.
+type VideoRetention struct {
+	// MaxAge removes videos of plays older than this.
+	MaxAge time.Duration
+	// MaxPerSong keeps only the newest videos of each song per player.
+	MaxPerSong int
+}
+
+// VideoArchiver downloads play videos through an EaClient into a
+// BlobStore before they expire.
+type VideoArchiver struct {
+	client    util.EaClient
+	store     util.BlobStore
+	Retention VideoRetention
+
+	chunkSize int
+}
+
+func NewVideoArchiver(client util.EaClient, store util.BlobStore) *VideoArchiver {
+	archiver := new(VideoArchiver)
+	archiver.client = client
+	archiver.store = store
+	archiver.chunkSize = videoChunkSize
+	return archiver
+}
+
+// VideoArchiveResult lists the keys affected by an archive run.
+type VideoArchiveResult struct {
+	Downloaded []string
+	Skipped    []string
+	Failed     map[string]error
+	Pruned     []string
+}
+
+// VideoKey returns the blob key of the video for a play, made from the
+// player, song and play time.
+func VideoKey(score drs_models.PlayerScore) string {
+	return fmt.Sprintf("%s%d/%s/%d", videoKeyPrefix, score.PlayerCode, score.SongId, score.PlayTime.UnixNano()/int64(time.Millisecond))
+}
+
+// Archive will download the video of every score that has one and is not
+// already archived, then apply the retention policy. Interrupted
+// downloads are kept and resumed on the next run. An error is returned if
+// any video failed, with the details in the result.
+func (archiver *VideoArchiver) Archive(scores []drs_models.PlayerScore) (result VideoArchiveResult, err error) {
+	result.Failed = make(map[string]error)
+	seenUrls := make(map[string]bool)
+
+	for _, score := range scores {
+		if score.VideoUrl == nil || *score.VideoUrl == "" {
+			continue
+		}
+		key := VideoKey(score)
+		if seenUrls[*score.VideoUrl] || !archiver.retained(key, time.Now()) {
+			result.Skipped = append(result.Skipped, key)
+			continue
+		}
+		seenUrls[*score.VideoUrl] = true
+
+		exists, existsErr := archiver.store.Exists(key)
+		if existsErr != nil {
+			result.Failed[key] = existsErr
+			continue
+		}
+		if exists {
+			result.Skipped = append(result.Skipped, key)
+			continue
+		}
+
+		if downloadErr := archiver.download(key, *score.VideoUrl); downloadErr != nil {
+			glog.Errorf("failed to archive video %s: %s\n", key, downloadErr.Error())
+			result.Failed[key] = downloadErr
+			continue
+		}
+		result.Downloaded = append(result.Downloaded, key)
+	}
+
+	result.Pruned, err = archiver.Prune()
+	if err == nil && len(result.Failed) > 0 {
+		err = fmt.Errorf("failed to archive %d videos", len(result.Failed))
+	}
+	return
+}
+
+// download fetches videoUrl into key, continuing from any partial
+// download stored for key. Each chunk is stored under its own key as it
+// is downloaded, so that a download can be resumed even if the process
+// is killed, and the chunks are only combined once the video is
+// complete.
+func (archiver *VideoArchiver) download(key string, videoUrl string) (err error) {
+	chunkKeys, offset, err := archiver.partialChunks(key)
+	if err != nil {
+		return
+	}
+
+	res, start, err := archiver.videoResponse(videoUrl, offset)
+	if err != nil {
+		return
+	}
+	if start != offset {
+		// the server could not continue the range, so start again
+		if err = archiver.deleteChunks(chunkKeys); err != nil {
+			return
+		}
+		chunkKeys, offset = nil, 0
+	}
+	if res != nil {
+		defer res.Body.Close()
+		chunk := make([]byte, archiver.chunkSize)
+		for {
+			filled := 0
+			var readErr error
+			for filled < len(chunk) && readErr == nil {
+				var n int
+				n, readErr = res.Body.Read(chunk[filled:])
+				filled += n
+			}
+			if filled > 0 {
+				chunkKey := videoChunkKey(key, offset)
+				if err = archiver.store.Put(chunkKey, chunk[:filled]); err != nil {
+					return
+				}
+				chunkKeys = append(chunkKeys, chunkKey)
+				offset += filled
+			}
+			if readErr == io.EOF {
+				break
+			}
+			if readErr != nil {
+				return readErr
+			}
+		}
+	}
+
+	var video []byte
+	for _, chunkKey := range chunkKeys {
+		data, err := archiver.store.Get(chunkKey)
+		if err != nil {
+			return err
+		}
+		video = append(video, data...)
+	}
+	if err = archiver.store.Put(key, video); err != nil {
+		return
+	}
+	return archiver.deleteChunks(chunkKeys)
+}
+
+// partialChunks returns the keys of the stored chunks of a partial
+// download, in order, along with the number of bytes they hold. Only the
+// last chunk is read.
+func (archiver *VideoArchiver) partialChunks(key string) (chunkKeys []string, size int, err error) {
+	chunkKeys, err = archiver.store.List(key + partialVideoSuffix)
+	if err != nil || len(chunkKeys) == 0 {
+		return
+	}
+	last := chunkKeys[len(chunkKeys)-1]
+	start, err := strconv.Atoi(last[strings.LastIndex(last, "/")+1:])
+	if err != nil {
+		return
+	}
+	data, err := archiver.store.Get(last)
+	if err != nil {
+		return
+	}
+	size = start + len(data)
+	return
+}
+
+func (archiver *VideoArchiver) deleteChunks(chunkKeys []string) (err error) {
+	for _, chunkKey := range chunkKeys {
+		if err = archiver.store.Delete(chunkKey); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// videoChunkKey returns the key of the chunk of a partial download that
+// starts at offset. Offsets are zero padded so that the keys sort in
+// order.
+func videoChunkKey(key string, offset int) string {
+	return fmt.Sprintf("%s%s%016d", key, partialVideoSuffix, offset)
+}
+
+// videoResponse requests videoUrl from offset, following redirects to
+// the video host, and returns the offset the response body starts at. A
+// response that does not continue from offset is discarded and the
+// whole video is requested instead. If the range cannot be satisfied
+// because offset is already the size of the video, the response is nil
+// and start is offset.
+func (archiver *VideoArchiver) videoResponse(videoUrl string, offset int) (res *http.Response, start int, err error) {
+	// videos may be served through a redirect, which the EaClient would
+	// otherwise return as is
+	client := *archiver.client.Client
+	client.CheckRedirect = nil
+
+	for {
+		req, err := http.NewRequest(http.MethodGet, videoUrl, nil)
+		if err != nil {
+			return nil, 0, err
+		}
+		if offset > 0 {
+			req.Header.Set("Range", "bytes="+strconv.Itoa(offset)+"-")
+		}
+
+		glog.Infof("retrieving video %s from %d\n", videoUrl, offset)
+		res, err = client.Do(req)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		switch res.StatusCode {
+		case http.StatusOK:
+			return res, 0, nil
+		case http.StatusPartialContent:
+			if offset > 0 && contentRangeStart(res.Header.Get("Content-Range")) == int64(offset) {
+				return res, offset, nil
+			}
+			glog.Warningf("video %s returned range %q for offset %d, starting again\n", videoUrl, res.Header.Get("Content-Range"), offset)
+		case http.StatusRequestedRangeNotSatisfiable:
+			if offset > 0 && contentRangeSize(res.Header.Get("Content-Range")) == int64(offset) {
+				res.Body.Close()
+				return nil, offset, nil
+			}
+			glog.Warningf("video %s could not return range %q for offset %d, starting again\n", videoUrl, res.Header.Get("Content-Range"), offset)
+		default:
+			res.Body.Close()
+			return nil, 0, fmt.Errorf("video %s returned status %d", videoUrl, res.StatusCode)
+		}
+		res.Body.Close()
+		if offset == 0 {
+			return nil, 0, fmt.Errorf("video %s returned status %d to a full request", videoUrl, res.StatusCode)
+		}
+		offset = 0
+	}
+}
+
+// contentRangeStart returns the first byte of a "bytes start-end/size"
+// Content-Range header, or -1 if it cannot be parsed.
+func contentRangeStart(contentRange string) int64 {
+	if !strings.HasPrefix(contentRange, "bytes ") {
+		return -1
+	}
+	byteRange := strings.TrimPrefix(contentRange, "bytes ")
+	dash := strings.Index(byteRange, "-")
+	if dash < 0 {
+		return -1
+	}
+	start, err := strconv.ParseInt(byteRange[:dash], 10, 64)
+	if err != nil {
+		return -1
+	}
+	return start
+}
+
+// contentRangeSize returns the size of a "bytes start-end/size" or
+// "bytes */size" Content-Range header, or -1 if it cannot be parsed.
+func contentRangeSize(contentRange string) int64 {
+	if !strings.HasPrefix(contentRange, "bytes ") {
+		return -1
+	}
+	slash := strings.LastIndex(contentRange, "/")
+	if slash < 0 {
+		return -1
+	}
+	size, err := strconv.ParseInt(contentRange[slash+1:], 10, 64)
+	if err != nil {
+		return -1
+	}
+	return size
+}
+
+// Prune will delete the archived videos, including partial downloads,
+// that the retention policy no longer keeps.
+func (archiver *VideoArchiver) Prune() (pruned []string, err error) {
+	keys, err := archiver.store.List(videoKeyPrefix)
+	if err != nil {
+		return
+	}
+
+	now := time.Now()
+	bySong := make(map[string][]string)
+	for _, key := range keys {
+		if !archiver.retained(key, now) {
+			pruned = append(pruned, key)
+			continue
+		}
+		if !strings.Contains(key, partialVideoSuffix) {
+			song := key[:strings.LastIndex(key, "/")]
+			bySong[song] = append(bySong[song], key)
+		}
+	}
+
+	if archiver.Retention.MaxPerSong > 0 {
+		for _, songKeys := range bySong {
+			sort.Slice(songKeys, func(i, j int) bool {
+				return videoPlayTime(songKeys[i]).After(videoPlayTime(songKeys[j]))
+			})
+			if len(songKeys) > archiver.Retention.MaxPerSong {
+				pruned = append(pruned, songKeys[archiver.Retention.MaxPerSong:]...)
+			}
+		}
+	}
+	sort.Strings(pruned)
+
+	for _, key := range pruned {
+		if err = archiver.store.Delete(key); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// retained reports whether the retention policy keeps the video at key.
+func (archiver *VideoArchiver) retained(key string, now time.Time) bool {
+	if archiver.Retention.MaxAge <= 0 {
+		return true
+	}
+	return now.Sub(videoPlayTime(key)) <= archiver.Retention.MaxAge
+}
+
+// videoPlayTime returns the play time encoded in a video key or the key
+// of one of its chunks.
+func videoPlayTime(key string) time.Time {
+	if part := strings.Index(key, partialVideoSuffix); part >= 0 {
+		key = key[:part]
+	}
+	name := key[strings.LastIndex(key, "/")+1:]
+	millis, err := strconv.ParseInt(name, 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+	return time.Unix(0, millis*int64(time.Millisecond))
+}
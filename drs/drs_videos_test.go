@@ -0,0 +1,303 @@
+package drs
+
+import (
+	"fmt"
+	"github.com/chris-sg/eagate/util"
+	"github.com/chris-sg/eagate_models/drs_models"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// partialVideo returns the chunks of the partial download of key joined
+// together.
+func partialVideo(store util.BlobStore, key string) string {
+	chunkKeys, _ := store.List(key + partialVideoSuffix)
+	var video []byte
+	for _, chunkKey := range chunkKeys {
+		data, _ := store.Get(chunkKey)
+		video = append(video, data...)
+	}
+	return string(video)
+}
+
+func TestVideoArchiver(t *testing.T) {
+	// Setup test
+	const video = "0123456789abcdefghij"
+	interrupted := true
+	ranges := []string{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ranges = append(ranges, r.Header.Get("Range"))
+		if interrupted {
+			// promise the full video but stop half way through
+			w.Header().Set("Content-Length", fmt.Sprintf("%d", len(video)))
+			w.Write([]byte(video[:10]))
+			return
+		}
+		if rangeHeader := r.Header.Get("Range"); strings.HasPrefix(rangeHeader, "bytes=10-") {
+			w.Header().Set("Content-Range", fmt.Sprintf("bytes 10-%d/%d", len(video)-1, len(video)))
+			w.WriteHeader(http.StatusPartialContent)
+			w.Write([]byte(video[10:]))
+			return
+		}
+		w.Write([]byte(video))
+	}))
+	defer ts.Close()
+
+	now := time.Now()
+	videoUrl := ts.URL + "/video.mp4"
+	oldUrl := ts.URL + "/old.mp4"
+	scores := []drs_models.PlayerScore{
+		{PlayerCode: 1, SongId: "1001", PlayTime: now, VideoUrl: &videoUrl},
+		{PlayerCode: 1, SongId: "1001", PlayTime: now.Add(-48 * time.Hour), VideoUrl: &oldUrl},
+		{PlayerCode: 1, SongId: "1002", PlayTime: now},
+	}
+	store := util.NewMemoryBlobStore()
+	archiver := NewVideoArchiver(util.GenerateClient(), store)
+	archiver.Retention.MaxAge = 24 * time.Hour
+	key := VideoKey(scores[0])
+
+	// Run Test
+	result, err := archiver.Archive(scores)
+	if err == nil || result.Failed[key] == nil {
+		t.Fatalf("expected the interrupted download to fail, got %+#v", result)
+	}
+	if partial := partialVideo(store, key); partial != video[:10] {
+		t.Fatalf("expected the partial download to be kept, got %q", partial)
+	}
+
+	interrupted = false
+	result, err = archiver.Archive(scores)
+	if err != nil {
+		t.Fatalf("unexpected error %s", err.Error())
+	}
+	if len(result.Downloaded) != 1 || result.Downloaded[0] != key {
+		t.Errorf("unexpected downloads %+#v", result)
+	}
+	if data, err := store.Get(key); err != nil || string(data) != video {
+		t.Errorf("expected the resumed video, got %q %v", data, err)
+	}
+	if chunkKeys, _ := store.List(key + partialVideoSuffix); len(chunkKeys) != 0 {
+		t.Errorf("expected the partial download to be removed, got %v", chunkKeys)
+	}
+	if ranges[len(ranges)-1] != "bytes=10-" {
+		t.Errorf("expected the download to resume, got ranges %v", ranges)
+	}
+
+	requests := len(ranges)
+	result, err = archiver.Archive(scores)
+	if err != nil || len(result.Downloaded) != 0 || len(ranges) != requests {
+		t.Errorf("expected archived videos to be skipped, got %+#v %v", result, err)
+	}
+}
+
+func TestVideoArchiverKeepsChunksDuringDownload(t *testing.T) {
+	// Setup test
+	const video = "0123456789abcdefghij"
+	release := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Length", fmt.Sprintf("%d", len(video)))
+		w.Write([]byte(video[:8]))
+		w.(http.Flusher).Flush()
+		<-release
+		w.Write([]byte(video[8:]))
+	}))
+	defer ts.Close()
+
+	videoUrl := ts.URL + "/video.mp4"
+	score := drs_models.PlayerScore{PlayerCode: 1, SongId: "1001", PlayTime: time.Now(), VideoUrl: &videoUrl}
+	store := util.NewMemoryBlobStore()
+	archiver := NewVideoArchiver(util.GenerateClient(), store)
+	archiver.chunkSize = 4
+	key := VideoKey(score)
+
+	// Run Test
+	done := make(chan error)
+	go func() {
+		_, err := archiver.Archive([]drs_models.PlayerScore{score})
+		done <- err
+	}()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		if partialVideo(store, key) == video[:8] {
+			break
+		}
+		if time.Now().After(deadline) {
+			close(release)
+			t.Fatalf("expected the partial download to be saved while downloading")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	close(release)
+
+	if err := <-done; err != nil {
+		t.Fatalf("unexpected error %s", err.Error())
+	}
+	if data, err := store.Get(key); err != nil || string(data) != video {
+		t.Errorf("expected the full video, got %q %v", data, err)
+	}
+}
+
+func TestVideoArchiverRestartsOnWrongRange(t *testing.T) {
+	// Setup test
+	const video = "0123456789abcdefghij"
+	ranges := []string{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ranges = append(ranges, r.Header.Get("Range"))
+		if r.Header.Get("Range") != "" {
+			// answer with the wrong part of the video
+			w.Header().Set("Content-Range", fmt.Sprintf("bytes 0-%d/%d", len(video)-1, len(video)))
+			w.WriteHeader(http.StatusPartialContent)
+			w.Write([]byte(video))
+			return
+		}
+		w.Write([]byte(video))
+	}))
+	defer ts.Close()
+
+	videoUrl := ts.URL + "/video.mp4"
+	score := drs_models.PlayerScore{PlayerCode: 1, SongId: "1001", PlayTime: time.Now(), VideoUrl: &videoUrl}
+	store := util.NewMemoryBlobStore()
+	archiver := NewVideoArchiver(util.GenerateClient(), store)
+	key := VideoKey(score)
+	store.Put(videoChunkKey(key, 0), []byte(video[:10]))
+
+	// Run Test
+	if _, err := archiver.Archive([]drs_models.PlayerScore{score}); err != nil {
+		t.Fatalf("unexpected error %s", err.Error())
+	}
+	if data, err := store.Get(key); err != nil || string(data) != video {
+		t.Errorf("expected the restarted video, got %q %v", data, err)
+	}
+	if len(ranges) != 2 || ranges[0] != "bytes=10-" || ranges[1] != "" {
+		t.Errorf("expected a ranged request then a full request, got %v", ranges)
+	}
+}
+
+func TestVideoArchiverCompletesOnUnsatisfiableRange(t *testing.T) {
+	// Setup test
+	const video = "0123456789abcdefghij"
+	ranges := []string{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ranges = append(ranges, r.Header.Get("Range"))
+		w.Header().Set("Content-Range", fmt.Sprintf("bytes */%d", len(video)))
+		w.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+	}))
+	defer ts.Close()
+
+	videoUrl := ts.URL + "/video.mp4"
+	score := drs_models.PlayerScore{PlayerCode: 1, SongId: "1001", PlayTime: time.Now(), VideoUrl: &videoUrl}
+	store := util.NewMemoryBlobStore()
+	archiver := NewVideoArchiver(util.GenerateClient(), store)
+	key := VideoKey(score)
+	store.Put(videoChunkKey(key, 0), []byte(video[:10]))
+	store.Put(videoChunkKey(key, 10), []byte(video[10:]))
+
+	// Run Test
+	if _, err := archiver.Archive([]drs_models.PlayerScore{score}); err != nil {
+		t.Fatalf("unexpected error %s", err.Error())
+	}
+	if data, err := store.Get(key); err != nil || string(data) != video {
+		t.Errorf("expected the stored chunks to complete the video, got %q %v", data, err)
+	}
+	if partial := partialVideo(store, key); partial != "" {
+		t.Errorf("expected the chunks to be removed, got %q", partial)
+	}
+	if len(ranges) != 1 || ranges[0] != "bytes=20-" {
+		t.Errorf("expected a single ranged request, got %v", ranges)
+	}
+}
+
+func TestVideoArchiverRestartsOnUnsatisfiableRange(t *testing.T) {
+	// Setup test
+	const video = "0123456789abcdefghij"
+	ranges := []string{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ranges = append(ranges, r.Header.Get("Range"))
+		if r.Header.Get("Range") != "" {
+			w.Header().Set("Content-Range", fmt.Sprintf("bytes */%d", len(video)))
+			w.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+			return
+		}
+		w.Write([]byte(video))
+	}))
+	defer ts.Close()
+
+	videoUrl := ts.URL + "/video.mp4"
+	score := drs_models.PlayerScore{PlayerCode: 1, SongId: "1001", PlayTime: time.Now(), VideoUrl: &videoUrl}
+	store := util.NewMemoryBlobStore()
+	archiver := NewVideoArchiver(util.GenerateClient(), store)
+	key := VideoKey(score)
+	// a partial download longer than the video cannot be continued
+	store.Put(videoChunkKey(key, 0), []byte(video+"stale"))
+
+	// Run Test
+	if _, err := archiver.Archive([]drs_models.PlayerScore{score}); err != nil {
+		t.Fatalf("unexpected error %s", err.Error())
+	}
+	if data, err := store.Get(key); err != nil || string(data) != video {
+		t.Errorf("expected the restarted video, got %q %v", data, err)
+	}
+	if len(ranges) != 2 || ranges[0] != "bytes=25-" || ranges[1] != "" {
+		t.Errorf("expected a ranged request then a full request, got %v", ranges)
+	}
+}
+
+func TestVideoArchiverFollowsRedirects(t *testing.T) {
+	// Setup test
+	const video = "0123456789abcdefghij"
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/redirect.mp4" {
+			http.Redirect(w, r, "/video.mp4", http.StatusFound)
+			return
+		}
+		w.Write([]byte(video))
+	}))
+	defer ts.Close()
+
+	videoUrl := ts.URL + "/redirect.mp4"
+	score := drs_models.PlayerScore{PlayerCode: 1, SongId: "1001", PlayTime: time.Now(), VideoUrl: &videoUrl}
+	store := util.NewMemoryBlobStore()
+	client := util.GenerateClient()
+	archiver := NewVideoArchiver(client, store)
+
+	// Run Test
+	if _, err := archiver.Archive([]drs_models.PlayerScore{score}); err != nil {
+		t.Fatalf("unexpected error %s", err.Error())
+	}
+	if data, err := store.Get(VideoKey(score)); err != nil || string(data) != video {
+		t.Errorf("expected the redirected video, got %q %v", data, err)
+	}
+	if client.Client.CheckRedirect == nil {
+		t.Errorf("expected the client to keep its redirect policy")
+	}
+}
+
+func TestVideoArchiverPrune(t *testing.T) {
+	// Setup test
+	store := util.NewMemoryBlobStore()
+	archiver := NewVideoArchiver(util.GenerateClient(), store)
+	archiver.Retention.MaxPerSong = 1
+	now := time.Now()
+	newest := VideoKey(drs_models.PlayerScore{PlayerCode: 1, SongId: "1001", PlayTime: now})
+	older := VideoKey(drs_models.PlayerScore{PlayerCode: 1, SongId: "1001", PlayTime: now.Add(-time.Hour)})
+	other := VideoKey(drs_models.PlayerScore{PlayerCode: 2, SongId: "1001", PlayTime: now.Add(-time.Hour)})
+	for _, key := range []string{newest, older, other, videoChunkKey(newest, 0)} {
+		store.Put(key, []byte("video"))
+	}
+
+	// Run Test
+	pruned, err := archiver.Prune()
+	if err != nil {
+		t.Fatalf("unexpected error %s", err.Error())
+	}
+	if len(pruned) != 1 || pruned[0] != older {
+		t.Errorf("expected only %s to be pruned, got %v", older, pruned)
+	}
+	if exists, _ := store.Exists(older); exists {
+		t.Errorf("expected %s to be deleted", older)
+	}
+}
@@ -0,0 +1,266 @@
+package scoring
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/chris-sg/eagate/ddr"
+	"github.com/chris-sg/eagate_models/ddr_models"
+)
+
+// RecommendationKind is the reason a chart was suggested for practice.
+type RecommendationKind int
+
+const (
+	// NearNextDanceLevel charts are a small score gain away from the next
+	// dance level.
+	NearNextDanceLevel RecommendationKind = iota
+	// BelowLevelMedian charts score well below the player's other charts
+	// of the same level.
+	BelowLevelMedian
+	// UnplayedAtComfortLevel charts have never been played and are at the
+	// level the player usually clears.
+	UnplayedAtComfortLevel
+)
+
+var recommendationKindLabels = [...]string{
+	"NEAR NEXT DANCE LEVEL",
+	"BELOW LEVEL MEDIAN",
+	"UNPLAYED AT COMFORT LEVEL",
+}
+
+func (kind RecommendationKind) String() string {
+	if kind < 0 || int(kind) >= len(recommendationKindLabels) {
+		return ""
+	}
+	return recommendationKindLabels[kind]
+}
+
+// Recommendation is a chart suggested for practice. Gap is the score
+// needed to reach TargetScore, and Reason explains the suggestion.
+type Recommendation struct {
+	Chart       ddr_models.SongDifficulty
+	Kind        RecommendationKind
+	Score       int
+	TargetScore int
+	Gap         int
+	Reason      string
+}
+
+// RecommendationOptions tunes which charts are suggested.
+type RecommendationOptions struct {
+	// NearThreshold is the largest gap to the next dance level that is
+	// still considered near.
+	NearThreshold int
+	// MedianMargin is how far below the level median a score must be.
+	MedianMargin int
+	// MinPlayedForMedian is the fewest played charts a level needs before
+	// its median is used.
+	MinPlayedForMedian int
+	// PerLevel limits the below median suggestions for each level.
+	PerLevel int
+	// MaxUnplayed limits the unplayed suggestions for each mode.
+	MaxUnplayed int
+}
+
+// DefaultRecommendationOptions returns the options used for any field
+// left at zero.
+func DefaultRecommendationOptions() RecommendationOptions {
+	return RecommendationOptions{
+		NearThreshold:      10000,
+		MedianMargin:       30000,
+		MinPlayedForMedian: 3,
+		PerLevel:           3,
+		MaxUnplayed:        5,
+	}
+}
+
+func (options RecommendationOptions) withDefaults() RecommendationOptions {
+	defaults := DefaultRecommendationOptions()
+	if options.NearThreshold <= 0 {
+		options.NearThreshold = defaults.NearThreshold
+	}
+	if options.MedianMargin <= 0 {
+		options.MedianMargin = defaults.MedianMargin
+	}
+	if options.MinPlayedForMedian <= 0 {
+		options.MinPlayedForMedian = defaults.MinPlayedForMedian
+	}
+	if options.PerLevel <= 0 {
+		options.PerLevel = defaults.PerLevel
+	}
+	if options.MaxUnplayed <= 0 {
+		options.MaxUnplayed = defaults.MaxUnplayed
+	}
+	return options
+}
+
+// Recommendations will suggest charts to practice from a player's
+// statistics. Each chart is suggested at most once, under the reason with
+// the smallest gap. Charts with a score are ranked by their gap, smallest
+// first, followed by unplayed charts. Level medians only count cleared
+// charts, and failed charts are not suggested against them.
+func Recommendations(difficulties []ddr_models.SongDifficulty, statistics []ddr_models.SongStatistics, options RecommendationOptions) (recommendations []Recommendation) {
+	options = options.withDefaults()
+
+	statisticsByChart := make(map[string]ddr_models.SongStatistics)
+	for _, stats := range statistics {
+		statisticsByChart[recommendationChartKey(stats.SongId, stats.Mode, stats.Difficulty)] = stats
+	}
+
+	type levelKey struct {
+		mode  string
+		level int16
+	}
+	played := make(map[levelKey][]Recommendation)
+	unplayed := make(map[levelKey][]ddr_models.SongDifficulty)
+	cleared := make(map[string][]int)
+	best := make(map[string]Recommendation)
+
+	suggest := func(recommendation Recommendation) {
+		key := recommendationChartKey(recommendation.Chart.SongId, recommendation.Chart.Mode, recommendation.Chart.Difficulty)
+		if existing, ok := best[key]; ok && existing.Gap <= recommendation.Gap {
+			return
+		}
+		best[key] = recommendation
+	}
+
+	for _, chart := range difficulties {
+		if chart.DifficultyValue <= 0 {
+			continue
+		}
+		key := levelKey{chart.Mode, chart.DifficultyValue}
+		stats, ok := statisticsByChart[recommendationChartKey(chart.SongId, chart.Mode, chart.Difficulty)]
+		if !ok || (stats.PlayCount == 0 && stats.BestScore == 0) {
+			unplayed[key] = append(unplayed[key], chart)
+			continue
+		}
+		failed := stats.ClearCount == 0 || ddr.StringToDanceLevel(stats.Rank) == ddr.DanceLevelE
+		if failed {
+			continue
+		}
+		cleared[chart.Mode] = append(cleared[chart.Mode], int(chart.DifficultyValue))
+		played[key] = append(played[key], Recommendation{Chart: chart, Score: stats.BestScore})
+
+		level, target := nextDanceLevel(stats.BestScore)
+		if level == ddr.NoDanceLevel || target-stats.BestScore > options.NearThreshold {
+			continue
+		}
+		suggest(Recommendation{
+			Chart:       chart,
+			Kind:        NearNextDanceLevel,
+			Score:       stats.BestScore,
+			TargetScore: target,
+			Gap:         target - stats.BestScore,
+			Reason: fmt.Sprintf("%s is %s short of %s (%s)",
+				formatScore(stats.BestScore), formatScore(target-stats.BestScore), level.String(), formatScore(target)),
+		})
+	}
+
+	for key, charts := range played {
+		if len(charts) < options.MinPlayedForMedian {
+			continue
+		}
+		sort.Slice(charts, func(i, j int) bool {
+			return charts[i].Score < charts[j].Score
+		})
+		median := medianScore(charts)
+		for i, chart := range charts {
+			if i >= options.PerLevel || median-chart.Score < options.MedianMargin {
+				break
+			}
+			suggest(Recommendation{
+				Chart:       chart.Chart,
+				Kind:        BelowLevelMedian,
+				Score:       chart.Score,
+				TargetScore: median,
+				Gap:         median - chart.Score,
+				Reason: fmt.Sprintf("%s is %s below your median of %s for level %d %s",
+					formatScore(chart.Score), formatScore(median-chart.Score), formatScore(median), key.level, strings.ToLower(key.mode)),
+			})
+		}
+	}
+
+	for _, recommendation := range best {
+		recommendations = append(recommendations, recommendation)
+	}
+	sort.Slice(recommendations, func(i, j int) bool {
+		a, b := recommendations[i], recommendations[j]
+		if a.Gap != b.Gap {
+			return a.Gap < b.Gap
+		}
+		return recommendationChartKey(a.Chart.SongId, a.Chart.Mode, a.Chart.Difficulty) <
+			recommendationChartKey(b.Chart.SongId, b.Chart.Mode, b.Chart.Difficulty)
+	})
+
+	modes := make([]string, 0, len(cleared))
+	for mode := range cleared {
+		modes = append(modes, mode)
+	}
+	sort.Strings(modes)
+	for _, mode := range modes {
+		comfort := comfortLevel(cleared[mode])
+		charts := unplayed[levelKey{mode, int16(comfort)}]
+		sort.Slice(charts, func(i, j int) bool {
+			return charts[i].SongId < charts[j].SongId
+		})
+		for i, chart := range charts {
+			if i >= options.MaxUnplayed {
+				break
+			}
+			recommendations = append(recommendations, Recommendation{
+				Chart:  chart,
+				Kind:   UnplayedAtComfortLevel,
+				Reason: fmt.Sprintf("never played, and level %d is the median level of your %s clears", comfort, strings.ToLower(mode)),
+			})
+		}
+	}
+	return
+}
+
+// nextDanceLevel returns the next dance level above score and the score
+// it needs, or NoDanceLevel if score is already AAA.
+func nextDanceLevel(score int) (level ddr.DanceLevel, target int) {
+	level = ddr.NoDanceLevel
+	for _, threshold := range danceLevelThresholds {
+		if threshold.score <= score {
+			break
+		}
+		level, target = threshold.level, threshold.score
+	}
+	return
+}
+
+// medianScore returns the median of charts sorted by score.
+func medianScore(charts []Recommendation) int {
+	middle := len(charts) / 2
+	if len(charts)%2 == 1 {
+		return charts[middle].Score
+	}
+	return (charts[middle-1].Score + charts[middle].Score) / 2
+}
+
+// comfortLevel returns the median level of the cleared charts.
+func comfortLevel(levels []int) int {
+	sorted := append([]int(nil), levels...)
+	sort.Ints(sorted)
+	return sorted[len(sorted)/2]
+}
+
+func recommendationChartKey(songId string, mode string, difficulty string) string {
+	return songId + "|" + mode + "|" + difficulty
+}
+
+// formatScore formats a score with thousands separators.
+func formatScore(score int) string {
+	digits := fmt.Sprintf("%d", score)
+	var b strings.Builder
+	for i, digit := range digits {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			b.WriteByte(',')
+		}
+		b.WriteRune(digit)
+	}
+	return b.String()
+}
@@ -0,0 +1,94 @@
+package scoring
+
+import (
+	"github.com/chris-sg/eagate_models/ddr_models"
+	"testing"
+)
+
+func TestRecommendations(t *testing.T) {
+	// Setup test
+	difficulties := []ddr_models.SongDifficulty{
+		{SongId: "a", Mode: "SINGLE", Difficulty: "EXPERT", DifficultyValue: 14},
+		{SongId: "b", Mode: "SINGLE", Difficulty: "EXPERT", DifficultyValue: 14},
+		{SongId: "c", Mode: "SINGLE", Difficulty: "EXPERT", DifficultyValue: 14},
+		{SongId: "d", Mode: "SINGLE", Difficulty: "EXPERT", DifficultyValue: 14},
+		{SongId: "e", Mode: "SINGLE", Difficulty: "EXPERT", DifficultyValue: 14},
+		{SongId: "f", Mode: "SINGLE", Difficulty: "CHALLENGE", DifficultyValue: 17},
+	}
+	statistics := []ddr_models.SongStatistics{
+		{SongId: "a", Mode: "SINGLE", Difficulty: "EXPERT", BestScore: 985000, Rank: "AA+", PlayCount: 4, ClearCount: 4},
+		{SongId: "b", Mode: "SINGLE", Difficulty: "EXPERT", BestScore: 950000, Rank: "AA+", PlayCount: 2, ClearCount: 2},
+		{SongId: "c", Mode: "SINGLE", Difficulty: "EXPERT", BestScore: 960000, Rank: "AA+", PlayCount: 2, ClearCount: 2},
+		{SongId: "d", Mode: "SINGLE", Difficulty: "EXPERT", BestScore: 870000, Rank: "A+", PlayCount: 1, ClearCount: 1},
+	}
+
+	// Setup expected results
+	expected := []struct {
+		songId string
+		kind   RecommendationKind
+		gap    int
+		reason string
+	}{
+		{"a", NearNextDanceLevel, 5000, "985,000 is 5,000 short of AAA (990,000)"},
+		{"d", BelowLevelMedian, 85000, "870,000 is 85,000 below your median of 955,000 for level 14 single"},
+		{"e", UnplayedAtComfortLevel, 0, "never played, and level 14 is the median level of your single clears"},
+	}
+
+	// Run Test
+	recommendations := Recommendations(difficulties, statistics, DefaultRecommendationOptions())
+	if len(recommendations) != len(expected) {
+		t.Fatalf("expected %d recommendations, got %+#v", len(expected), recommendations)
+	}
+	for i, e := range expected {
+		r := recommendations[i]
+		if r.Chart.SongId != e.songId || r.Kind != e.kind || r.Gap != e.gap || r.Reason != e.reason {
+			t.Errorf("expected %+#v at %d, got %+#v", e, i, r)
+		}
+	}
+}
+
+func TestRecommendationsIgnoresFailedPlays(t *testing.T) {
+	// Setup test
+	difficulties := []ddr_models.SongDifficulty{
+		{SongId: "a", Mode: "SINGLE", Difficulty: "EXPERT", DifficultyValue: 14},
+		{SongId: "b", Mode: "SINGLE", Difficulty: "EXPERT", DifficultyValue: 14},
+		{SongId: "c", Mode: "SINGLE", Difficulty: "EXPERT", DifficultyValue: 14},
+		{SongId: "d", Mode: "SINGLE", Difficulty: "EXPERT", DifficultyValue: 14},
+		{SongId: "g", Mode: "SINGLE", Difficulty: "EXPERT", DifficultyValue: 14},
+	}
+	statistics := []ddr_models.SongStatistics{
+		{SongId: "a", Mode: "SINGLE", Difficulty: "EXPERT", BestScore: 985000, Rank: "AA+", PlayCount: 4, ClearCount: 4},
+		{SongId: "b", Mode: "SINGLE", Difficulty: "EXPERT", BestScore: 950000, Rank: "AA+", PlayCount: 2, ClearCount: 2},
+		{SongId: "c", Mode: "SINGLE", Difficulty: "EXPERT", BestScore: 960000, Rank: "AA+", PlayCount: 2, ClearCount: 2},
+		{SongId: "d", Mode: "SINGLE", Difficulty: "EXPERT", BestScore: 870000, Rank: "A+", PlayCount: 1, ClearCount: 1},
+		{SongId: "g", Mode: "SINGLE", Difficulty: "EXPERT", BestScore: 500000, Rank: "E", PlayCount: 3, ClearCount: 0},
+	}
+
+	// Setup expected results
+	expected := []struct {
+		songId string
+		kind   RecommendationKind
+		gap    int
+	}{
+		{"a", NearNextDanceLevel, 5000},
+		{"d", BelowLevelMedian, 85000},
+	}
+
+	// Run Test
+	recommendations := Recommendations(difficulties, statistics, RecommendationOptions{})
+	if len(recommendations) != len(expected) {
+		t.Fatalf("expected %d recommendations, got %+#v", len(expected), recommendations)
+	}
+	for i, e := range expected {
+		r := recommendations[i]
+		if r.Chart.SongId != e.songId || r.Kind != e.kind || r.Gap != e.gap {
+			t.Errorf("expected %+#v at %d, got %+#v", e, i, r)
+		}
+	}
+}
+
+func TestRecommendationsEmpty(t *testing.T) {
+	if recommendations := Recommendations(nil, nil, DefaultRecommendationOptions()); len(recommendations) != 0 {
+		t.Errorf("expected no recommendations, got %+#v", recommendations)
+	}
+}
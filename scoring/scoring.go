@@ -0,0 +1,237 @@
+// Package scoring calculates DDR money scores, EX scores and dance levels
+// from judgement counts, and checks recorded scores against them. Note
+// counts come from simfiles, as the e-amusement pages do not show them.
+package scoring
+
+import (
+	"fmt"
+
+	"github.com/chris-sg/eagate/ddr"
+	"github.com/chris-sg/eagate/simfile"
+)
+
+// MaxScore is the money score of a play where every step is Marvelous
+// and every freeze and shock arrow is O.K.
+const MaxScore = 1000000
+
+// Judgements is the judgement breakdown of a play. Miss includes both
+// missed steps and freeze or shock arrows judged N.G.
+type Judgements struct {
+	Marvelous int
+	Perfect   int
+	Great     int
+	Good      int
+	Ok        int
+	Miss      int
+}
+
+// NoteCounts are the judged objects of a chart. A jump is a single step,
+// and a row of shock arrows is a single shock arrow.
+type NoteCounts struct {
+	Steps        int
+	FreezeArrows int
+	ShockArrows  int
+}
+
+// NoteCountsFromSimfile returns the note counts of a simfile chart. Holds
+// and rolls are both counted as freeze arrows.
+func NoteCountsFromSimfile(stats simfile.ChartStats) NoteCounts {
+	return NoteCounts{
+		Steps:        stats.Steps,
+		FreezeArrows: stats.Holds + stats.Rolls,
+		ShockArrows:  stats.MineRows,
+	}
+}
+
+// Total returns the number of judged objects in the chart.
+func (counts NoteCounts) Total() int {
+	return counts.Steps + counts.FreezeArrows + counts.ShockArrows
+}
+
+// Play is a recorded play to check against its judgements. A zero ExScore,
+// MaxCombo, Fast or Slow is treated as not recorded.
+type Play struct {
+	Judgements
+
+	Score    int
+	ExScore  int
+	MaxCombo int
+	Fast     int
+	Slow     int
+}
+
+// Total returns the number of judged objects.
+func (j Judgements) Total() int {
+	return j.Marvelous + j.Perfect + j.Great + j.Good + j.Ok + j.Miss
+}
+
+// ExScore returns the EX score for the judgements: 3 for a Marvelous or
+// O.K., 2 for a Perfect and 1 for a Great.
+func ExScore(j Judgements) int {
+	return (j.Marvelous+j.Ok)*3 + j.Perfect*2 + j.Great
+}
+
+// MoneyScore returns the score for the judgements on a chart. Each object
+// is worth 1,000,000 divided by the number of objects in the chart. A
+// Marvelous or O.K. scores the full value, a Perfect the full value less
+// 10, a Great 60% less 10 and a Good 20% less 10. The total is rounded
+// down to a multiple of 10.
+func MoneyScore(counts NoteCounts, j Judgements) int {
+	total := counts.Total()
+	if total == 0 {
+		return 0
+	}
+	// scaled by 5 * total so the calculation stays in integers
+	scaled := 5*MaxScore*(j.Marvelous+j.Ok) +
+		j.Perfect*(5*MaxScore-50*total) +
+		j.Great*(3*MaxScore-50*total) +
+		j.Good*(1*MaxScore-50*total)
+	if scaled < 0 {
+		return 0
+	}
+	return scaled / (50 * total) * 10
+}
+
+// MaxExScore returns the highest EX score possible on a chart, scoring 3
+// per step and 3 per successful freeze or shock arrow.
+func MaxExScore(counts NoteCounts) int {
+	return counts.Total() * 3
+}
+
+var danceLevelThresholds = []struct {
+	score int
+	level ddr.DanceLevel
+}{
+	{990000, ddr.DanceLevelAAA},
+	{950000, ddr.DanceLevelAAPlus},
+	{900000, ddr.DanceLevelAA},
+	{890000, ddr.DanceLevelAAMinus},
+	{850000, ddr.DanceLevelAPlus},
+	{800000, ddr.DanceLevelA},
+	{790000, ddr.DanceLevelAMinus},
+	{750000, ddr.DanceLevelBPlus},
+	{700000, ddr.DanceLevelB},
+	{690000, ddr.DanceLevelBMinus},
+	{650000, ddr.DanceLevelCPlus},
+	{600000, ddr.DanceLevelC},
+	{590000, ddr.DanceLevelCMinus},
+	{550000, ddr.DanceLevelDPlus},
+	{0, ddr.DanceLevelD},
+}
+
+// DanceLevelForScore returns the dance level awarded for a cleared play
+// with score. A failed play is always awarded an E.
+func DanceLevelForScore(score int) ddr.DanceLevel {
+	for _, threshold := range danceLevelThresholds {
+		if score >= threshold.score {
+			return threshold.level
+		}
+	}
+	return ddr.DanceLevelD
+}
+
+// ScoreForDanceLevel returns the lowest score that is awarded level, or
+// -1 for levels that are not awarded by score.
+func ScoreForDanceLevel(level ddr.DanceLevel) int {
+	for _, threshold := range danceLevelThresholds {
+		if threshold.level == level {
+			return threshold.score
+		}
+	}
+	return -1
+}
+
+// Inconsistency is a value recorded for a play that does not agree with
+// its judgement breakdown, usually pointing at a parser bug.
+type Inconsistency struct {
+	Field    string
+	Expected int
+	Actual   int
+}
+
+func (i Inconsistency) Error() string {
+	return fmt.Sprintf("%s is %d, expected %d", i.Field, i.Actual, i.Expected)
+}
+
+// Validate checks the scores of a play against its judgements and the
+// note counts of the chart.
+func Validate(play Play, counts NoteCounts) (inconsistencies []Inconsistency) {
+	j := play.Judgements
+	total := counts.Total()
+
+	if j.Total() != total {
+		inconsistencies = append(inconsistencies, Inconsistency{"judgements", total, j.Total()})
+	}
+	if steps := j.Marvelous + j.Perfect + j.Great + j.Good; steps > counts.Steps {
+		inconsistencies = append(inconsistencies, Inconsistency{"step judgements", counts.Steps, steps})
+	}
+	if counts.FreezeArrows+counts.ShockArrows < j.Ok {
+		inconsistencies = append(inconsistencies, Inconsistency{"O.K.", counts.FreezeArrows + counts.ShockArrows, j.Ok})
+	}
+	if score := MoneyScore(counts, j); score != play.Score {
+		inconsistencies = append(inconsistencies, Inconsistency{"score", score, play.Score})
+	}
+	if exScore := ExScore(j); play.ExScore != 0 && exScore != play.ExScore {
+		inconsistencies = append(inconsistencies, Inconsistency{"EX score", exScore, play.ExScore})
+	}
+	if play.MaxCombo > total {
+		inconsistencies = append(inconsistencies, Inconsistency{"max combo", total, play.MaxCombo})
+	}
+	if timed := j.Perfect + j.Great + j.Good; play.Fast+play.Slow > timed {
+		inconsistencies = append(inconsistencies, Inconsistency{"fast/slow", timed, play.Fast + play.Slow})
+	}
+	return
+}
+
+// Target describes how a chart can still reach a score. Each allowance is
+// the most judgements of that kind a play can have, with every other step
+// Marvelous and every freeze and shock arrow O.K., while scoring at least
+// Score. An allowance of -1 means the score cannot be reached at all.
+type Target struct {
+	Score           int
+	PerfectsAllowed int
+	GreatsAllowed   int
+	GoodsAllowed    int
+	MissesAllowed   int
+}
+
+// TargetForScore returns the judgements allowed on a chart while still
+// reaching score.
+func TargetForScore(counts NoteCounts, score int) Target {
+	return Target{
+		Score:           score,
+		PerfectsAllowed: allowed(counts, score, counts.Steps, func(n int) Judgements { return Judgements{Perfect: n} }),
+		GreatsAllowed:   allowed(counts, score, counts.Steps, func(n int) Judgements { return Judgements{Great: n} }),
+		GoodsAllowed:    allowed(counts, score, counts.Steps, func(n int) Judgements { return Judgements{Good: n} }),
+		MissesAllowed:   allowed(counts, score, counts.Total(), func(n int) Judgements { return Judgements{Miss: n} }),
+	}
+}
+
+// TargetForDanceLevel returns the judgements allowed on a chart while
+// still reaching level.
+func TargetForDanceLevel(counts NoteCounts, level ddr.DanceLevel) (target Target, err error) {
+	score := ScoreForDanceLevel(level)
+	if score < 0 {
+		err = fmt.Errorf("dance level %s is not awarded by score", level.String())
+		return
+	}
+	target = TargetForScore(counts, score)
+	return
+}
+
+// allowed returns the largest n up to limit where the judgements built by
+// judgements(n) still reach score.
+func allowed(counts NoteCounts, score int, limit int, judgements func(n int) Judgements) int {
+	best := -1
+	for n := 0; n <= limit; n++ {
+		j := judgements(n)
+		// the remaining steps are Marvelous and the remaining freeze
+		// and shock arrows O.K., which score the same
+		j.Marvelous = counts.Total() - j.Total()
+		if MoneyScore(counts, j) < score {
+			break
+		}
+		best = n
+	}
+	return best
+}
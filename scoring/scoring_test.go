@@ -0,0 +1,123 @@
+package scoring
+
+import (
+	"github.com/chris-sg/eagate/ddr"
+	"github.com/chris-sg/eagate/simfile"
+	"testing"
+)
+
+func TestMoneyScore(t *testing.T) {
+	// Setup test
+	chart := NoteCounts{Steps: 300, FreezeArrows: 20, ShockArrows: 4}
+	testCases := []struct {
+		judgements Judgements
+		expected   int
+	}{
+		{Judgements{Marvelous: 300, Ok: 24}, 1000000},
+		{Judgements{Marvelous: 299, Perfect: 1, Ok: 24}, 999990},
+		{Judgements{Marvelous: 298, Great: 1, Miss: 1, Ok: 24}, 995660},
+		{Judgements{Good: 300, Miss: 24}, 182180},
+		{Judgements{Miss: 324}, 0},
+	}
+
+	// Run Test
+	for _, testCase := range testCases {
+		if score := MoneyScore(chart, testCase.judgements); score != testCase.expected {
+			t.Errorf("expected score %d for %+#v, got %d", testCase.expected, testCase.judgements, score)
+		}
+	}
+	if score := MoneyScore(NoteCounts{}, Judgements{}); score != 0 {
+		t.Errorf("expected score 0 for an empty chart, got %d", score)
+	}
+}
+
+func TestDanceLevelForScore(t *testing.T) {
+	testCases := map[int]ddr.DanceLevel{
+		1000000: ddr.DanceLevelAAA,
+		990000:  ddr.DanceLevelAAA,
+		989990:  ddr.DanceLevelAAPlus,
+		890000:  ddr.DanceLevelAAMinus,
+		590000:  ddr.DanceLevelCMinus,
+		549990:  ddr.DanceLevelD,
+		0:       ddr.DanceLevelD,
+	}
+	for score, expected := range testCases {
+		if level := DanceLevelForScore(score); level != expected {
+			t.Errorf("expected %s for %d, got %s", expected.String(), score, level.String())
+		}
+	}
+	if score := ScoreForDanceLevel(ddr.DanceLevelE); score != -1 {
+		t.Errorf("expected E to not be awarded by score, got %d", score)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	// Setup test
+	chart := NoteCounts{Steps: 100}
+	play := Play{
+		Judgements: Judgements{Marvelous: 98, Perfect: 1, Great: 1},
+		Score:      995980,
+		MaxCombo:   100,
+		ExScore:    297,
+		Fast:       1,
+		Slow:       1,
+	}
+
+	// Run Test
+	if inconsistencies := Validate(play, chart); len(inconsistencies) != 0 {
+		t.Errorf("expected no inconsistencies, got %+#v", inconsistencies)
+	}
+
+	play.Score = 995990
+	play.Miss = 1
+	inconsistencies := Validate(play, chart)
+	if len(inconsistencies) != 2 {
+		t.Fatalf("expected 2 inconsistencies, got %+#v", inconsistencies)
+	}
+	if inconsistencies[0].Field != "judgements" || inconsistencies[1].Field != "score" || inconsistencies[1].Expected != 995980 {
+		t.Errorf("unexpected inconsistencies %+#v", inconsistencies)
+	}
+}
+
+func TestTargetForDanceLevel(t *testing.T) {
+	// Setup test
+	chart := NoteCounts{Steps: 100}
+	expected := Target{Score: 990000, PerfectsAllowed: 100, GreatsAllowed: 2, GoodsAllowed: 1, MissesAllowed: 1}
+
+	// Run Test
+	target, err := TargetForDanceLevel(chart, ddr.DanceLevelAAA)
+	if err != nil {
+		t.Fatalf("unexpected error %s", err.Error())
+	}
+	if target != expected {
+		t.Errorf("expected %+#v, got %+#v", expected, target)
+	}
+
+	if target := TargetForScore(chart, 1000001); target.PerfectsAllowed != -1 || target.MissesAllowed != -1 {
+		t.Errorf("expected an unreachable target, got %+#v", target)
+	}
+	if _, err := TargetForDanceLevel(chart, ddr.DanceLevelE); err == nil {
+		t.Errorf("expected an error for dance level E")
+	}
+}
+
+func TestNoteCountsFromSimfile(t *testing.T) {
+	// Setup test
+	const testFile = "../simfile/test_data/paranoia.sm"
+	parsed, err := simfile.ParseFile(testFile)
+	if err != nil {
+		t.Fatalf("could not parse %s: %s", testFile, err.Error())
+	}
+
+	// Setup expected results
+	expected := NoteCounts{Steps: 5, FreezeArrows: 2, ShockArrows: 1}
+
+	// Run Test
+	counts := NoteCountsFromSimfile(parsed.Charts[0].Stats)
+	if counts != expected {
+		t.Errorf("expected %+#v, got %+#v", expected, counts)
+	}
+	if maxExScore := MaxExScore(counts); maxExScore != 24 {
+		t.Errorf("expected a max EX score of 24, got %d", maxExScore)
+	}
+}
@@ -0,0 +1,138 @@
+package simfile
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/chris-sg/eagate_models/ddr_models"
+	"golang.org/x/text/unicode/norm"
+)
+
+// SimfileChart attaches the statistics of a simfile chart to the
+// ddr_models.SongDifficulty it was matched to.
+type SimfileChart struct {
+	ddr_models.SongDifficulty
+
+	Stats     ChartStats
+	MinBpm    float64
+	MaxBpm    float64
+	StopCount int
+	Path      string
+}
+
+// UnmatchedSimfile is a simfile that could not be matched to a song.
+// Key or Path can be used in the manual mappings passed to Match.
+type UnmatchedSimfile struct {
+	Path   string
+	Title  string
+	Artist string
+	Key    string
+}
+
+// MatchResult is the outcome of matching simfiles to songs.
+type MatchResult struct {
+	Charts []SimfileChart
+	// SongIds maps simfile paths to the song they were matched to.
+	SongIds   map[string]string
+	Unmatched []UnmatchedSimfile
+	// Ambiguous lists simfiles that share their key with another simfile,
+	// or whose key matches more than one song. They are only matched
+	// through a manual mapping by Path.
+	Ambiguous []UnmatchedSimfile
+}
+
+// NormaliseName folds a title or artist for matching, ignoring width,
+// case, punctuation and spacing.
+func NormaliseName(name string) string {
+	var b strings.Builder
+	for _, r := range norm.NFKC.String(name) {
+		if unicode.IsLetter(r) || unicode.IsNumber(r) {
+			b.WriteRune(unicode.ToLower(r))
+		}
+	}
+	return b.String()
+}
+
+// SimfileKey returns the key used to match a simfile to a song.
+func SimfileKey(simfile Simfile) string {
+	return NormaliseName(simfile.Title) + "|" + NormaliseName(simfile.Artist)
+}
+
+// Match will match simfiles to songs by normalised title and artist,
+// falling back to title alone where only one song has that title.
+// manual maps a simfile path or SimfileKey to a song id and takes
+// precedence, with paths checked first. Simfiles sharing a key, and keys
+// shared by several songs, are reported as ambiguous rather than matched
+// to whichever came last. Charts are only attached where the song has a
+// difficulty for the same mode and difficulty.
+func Match(simfiles []Simfile, songs []ddr_models.Song, difficulties []ddr_models.SongDifficulty, manual map[string]string) (result MatchResult) {
+	byKey := make(map[string][]string)
+	byTitle := make(map[string][]string)
+	for _, song := range songs {
+		key := NormaliseName(song.Name) + "|" + NormaliseName(song.Artist)
+		byKey[key] = append(byKey[key], song.Id)
+		title := NormaliseName(song.Name)
+		byTitle[title] = append(byTitle[title], song.Id)
+	}
+
+	simfileKeys := make(map[string]int)
+	for _, simfile := range simfiles {
+		simfileKeys[SimfileKey(simfile)]++
+	}
+
+	chartsBySong := make(map[string][]ddr_models.SongDifficulty)
+	for _, difficulty := range difficulties {
+		chartsBySong[difficulty.SongId] = append(chartsBySong[difficulty.SongId], difficulty)
+	}
+
+	result.SongIds = make(map[string]string)
+	for _, simfile := range simfiles {
+		key := SimfileKey(simfile)
+		unmatched := UnmatchedSimfile{
+			Path:   simfile.Path,
+			Title:  simfile.Title,
+			Artist: simfile.Artist,
+			Key:    key,
+		}
+		songId, ok := manual[simfile.Path]
+		if !ok && (simfileKeys[key] > 1 || len(byKey[key]) > 1) {
+			result.Ambiguous = append(result.Ambiguous, unmatched)
+			continue
+		}
+		if !ok {
+			songId, ok = manual[key]
+		}
+		if !ok && len(byKey[key]) == 1 {
+			songId, ok = byKey[key][0], true
+		}
+		if !ok {
+			if candidates := byTitle[NormaliseName(simfile.Title)]; len(candidates) == 1 {
+				songId, ok = candidates[0], true
+			}
+		}
+		if !ok {
+			result.Unmatched = append(result.Unmatched, unmatched)
+			continue
+		}
+		result.SongIds[simfile.Path] = songId
+
+		for _, chart := range simfile.Charts {
+			for _, difficulty := range chartsBySong[songId] {
+				if difficulty.Mode != chart.Mode() || difficulty.Difficulty != chart.DdrDifficulty() {
+					continue
+				}
+				_, stops := simfile.Timing(chart)
+				minBpm, maxBpm := simfile.BpmRange(chart)
+				result.Charts = append(result.Charts, SimfileChart{
+					SongDifficulty: difficulty,
+					Stats:          chart.Stats,
+					MinBpm:         minBpm,
+					MaxBpm:         maxBpm,
+					StopCount:      len(stops),
+					Path:           simfile.Path,
+				})
+			}
+		}
+	}
+	return
+}
@@ -0,0 +1,362 @@
+package simfile
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/chris-sg/eagate_models/ddr_models"
+)
+
+// Simfile is a parsed StepMania .sm or .ssc file.
+type Simfile struct {
+	Path     string
+	Title    string
+	Subtitle string
+	Artist   string
+	Bpms     []BpmChange
+	Stops    []Stop
+	Charts   []Chart
+}
+
+// BpmChange sets the BPM from Beat onwards.
+type BpmChange struct {
+	Beat float64
+	Bpm  float64
+}
+
+// Stop pauses the chart at Beat for Seconds.
+type Stop struct {
+	Beat    float64
+	Seconds float64
+}
+
+// Chart is a single set of steps within a simfile.
+type Chart struct {
+	StepsType  string
+	Difficulty string
+	Meter      int
+	// Bpms and Stops are only set when an .ssc chart overrides the
+	// song timing.
+	Bpms  []BpmChange
+	Stops []Stop
+	Stats ChartStats
+}
+
+// ChartStats counts the arrows in a chart. Steps counts rows with at
+// least one tap, hold or roll head, so a jump counts as a single step.
+// MineRows counts rows with at least one mine, as DDR judges a row of
+// shock arrows once.
+type ChartStats struct {
+	Steps    int
+	Jumps    int
+	Holds    int
+	Rolls    int
+	Mines    int
+	MineRows int
+}
+
+// Mode returns the ddr_models mode label for the chart, or an empty
+// string if the chart is not a dance-single or dance-double chart.
+func (chart Chart) Mode() string {
+	switch strings.ToLower(chart.StepsType) {
+	case "dance-single":
+		return ddr_models.Single.String()
+	case "dance-double":
+		return ddr_models.Double.String()
+	}
+	return ""
+}
+
+// DdrDifficulty returns the ddr_models difficulty label for the chart,
+// or an empty string for edit charts.
+func (chart Chart) DdrDifficulty() string {
+	difficulties := map[string]ddr_models.Difficulty{
+		"beginner":  ddr_models.Beginner,
+		"easy":      ddr_models.Basic,
+		"medium":    ddr_models.Difficult,
+		"hard":      ddr_models.Expert,
+		"challenge": ddr_models.Challenge,
+	}
+	difficulty, ok := difficulties[strings.ToLower(chart.Difficulty)]
+	if !ok {
+		return ""
+	}
+	return difficulty.String()
+}
+
+// Timing returns the BPM changes and stops that apply to a chart.
+func (simfile Simfile) Timing(chart Chart) (bpms []BpmChange, stops []Stop) {
+	bpms, stops = simfile.Bpms, simfile.Stops
+	if len(chart.Bpms) > 0 {
+		bpms = chart.Bpms
+	}
+	if len(chart.Stops) > 0 {
+		stops = chart.Stops
+	}
+	return
+}
+
+// BpmRange returns the lowest and highest BPM used by a chart.
+func (simfile Simfile) BpmRange(chart Chart) (minBpm float64, maxBpm float64) {
+	bpms, _ := simfile.Timing(chart)
+	for i, change := range bpms {
+		if i == 0 || change.Bpm < minBpm {
+			minBpm = change.Bpm
+		}
+		if change.Bpm > maxBpm {
+			maxBpm = change.Bpm
+		}
+	}
+	return
+}
+
+// ParseFile will parse the .sm or .ssc file at path.
+func ParseFile(path string) (simfile Simfile, err error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return
+	}
+	defer file.Close()
+	simfile, err = Parse(file)
+	simfile.Path = path
+	return
+}
+
+// ParseDirectory will parse every .sm and .ssc file below dir. Where a
+// song has both, only the .ssc file is used.
+func ParseDirectory(dir string) (simfiles []Simfile, err error) {
+	paths := make(map[string]string)
+	err = filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		ext := strings.ToLower(filepath.Ext(path))
+		if info.IsDir() || (ext != ".sm" && ext != ".ssc") {
+			return nil
+		}
+		base := strings.TrimSuffix(path, filepath.Ext(path))
+		if existing, ok := paths[base]; ok && strings.ToLower(filepath.Ext(existing)) == ".ssc" {
+			return nil
+		}
+		paths[base] = path
+		return nil
+	})
+	if err != nil {
+		return
+	}
+
+	var sorted []string
+	for _, path := range paths {
+		sorted = append(sorted, path)
+	}
+	sort.Strings(sorted)
+
+	for _, path := range sorted {
+		simfile, err := ParseFile(path)
+		if err != nil {
+			return simfiles, fmt.Errorf("failed to parse %s: %s", path, err.Error())
+		}
+		simfiles = append(simfiles, simfile)
+	}
+	return
+}
+
+// Parse will parse a simfile, detecting .ssc files by their NOTEDATA
+// sections.
+func Parse(r io.Reader) (simfile Simfile, err error) {
+	contents, err := ioutil.ReadAll(r)
+	if err != nil {
+		return
+	}
+
+	var chart *Chart
+	for _, tag := range tagsFromContents(string(contents)) {
+		switch tag.name {
+		case "TITLE":
+			simfile.Title = tag.value
+		case "SUBTITLE":
+			simfile.Subtitle = tag.value
+		case "ARTIST":
+			simfile.Artist = tag.value
+		case "BPMS":
+			bpms, parseErr := parseBpms(tag.value)
+			if parseErr != nil {
+				return simfile, parseErr
+			}
+			if chart != nil {
+				chart.Bpms = bpms
+			} else {
+				simfile.Bpms = bpms
+			}
+		case "STOPS":
+			stops, parseErr := parseStops(tag.value)
+			if parseErr != nil {
+				return simfile, parseErr
+			}
+			if chart != nil {
+				chart.Stops = stops
+			} else {
+				simfile.Stops = stops
+			}
+		case "NOTEDATA":
+			simfile.Charts = append(simfile.Charts, Chart{})
+			chart = &simfile.Charts[len(simfile.Charts)-1]
+		case "STEPSTYPE":
+			if chart != nil {
+				chart.StepsType = tag.value
+			}
+		case "DIFFICULTY":
+			if chart != nil {
+				chart.Difficulty = tag.value
+			}
+		case "METER":
+			if chart != nil {
+				chart.Meter, _ = strconv.Atoi(tag.value)
+			}
+		case "NOTES":
+			if chart != nil {
+				chart.Stats = statsFromNotes(tag.value)
+				continue
+			}
+			// .sm charts hold their details in the NOTES fields
+			fields := strings.SplitN(tag.value, ":", 6)
+			if len(fields) != 6 {
+				return simfile, fmt.Errorf("expected 6 NOTES fields, got %d", len(fields))
+			}
+			meter, _ := strconv.Atoi(strings.TrimSpace(fields[3]))
+			simfile.Charts = append(simfile.Charts, Chart{
+				StepsType:  strings.TrimSpace(fields[0]),
+				Difficulty: strings.TrimSpace(fields[2]),
+				Meter:      meter,
+				Stats:      statsFromNotes(fields[5]),
+			})
+		}
+	}
+	return
+}
+
+type simfileTag struct {
+	name  string
+	value string
+}
+
+// tagsFromContents splits a simfile into its #NAME:value; tags with
+// comments removed.
+func tagsFromContents(contents string) (tags []simfileTag) {
+	var stripped strings.Builder
+	for _, line := range strings.Split(contents, "\n") {
+		if i := strings.Index(line, "//"); i >= 0 {
+			line = line[:i]
+		}
+		stripped.WriteString(line)
+		stripped.WriteString("\n")
+	}
+
+	remaining := stripped.String()
+	for {
+		start := strings.Index(remaining, "#")
+		if start < 0 {
+			return
+		}
+		remaining = remaining[start+1:]
+		separator := strings.Index(remaining, ":")
+		if separator < 0 {
+			return
+		}
+		name := strings.ToUpper(strings.TrimSpace(remaining[:separator]))
+		remaining = remaining[separator+1:]
+
+		end := strings.Index(remaining, ";")
+		if next := strings.Index(remaining, "\n#"); end < 0 || (next >= 0 && next < end) {
+			// tolerate tags missing their terminating semicolon
+			end = next
+		}
+		if end < 0 {
+			end = len(remaining)
+		}
+		tags = append(tags, simfileTag{name, strings.TrimSpace(remaining[:end])})
+		remaining = remaining[end:]
+	}
+}
+
+func parseBeatValues(value string) (pairs [][2]float64, err error) {
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		parts := strings.SplitN(entry, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid timing entry %s", entry)
+		}
+		beat, err := strconv.ParseFloat(strings.TrimSpace(parts[0]), 64)
+		if err != nil {
+			return nil, err
+		}
+		v, err := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+		if err != nil {
+			return nil, err
+		}
+		pairs = append(pairs, [2]float64{beat, v})
+	}
+	return
+}
+
+func parseBpms(value string) (bpms []BpmChange, err error) {
+	pairs, err := parseBeatValues(value)
+	for _, pair := range pairs {
+		bpms = append(bpms, BpmChange{pair[0], pair[1]})
+	}
+	return
+}
+
+func parseStops(value string) (stops []Stop, err error) {
+	pairs, err := parseBeatValues(value)
+	for _, pair := range pairs {
+		stops = append(stops, Stop{pair[0], pair[1]})
+	}
+	return
+}
+
+// statsFromNotes counts the arrows in note data made of comma separated
+// measures of rows, where 1 is a tap, 2 a hold head, 4 a roll head and M
+// a mine.
+func statsFromNotes(notes string) (stats ChartStats) {
+	for _, row := range strings.FieldsFunc(notes, func(r rune) bool {
+		return r == '\n' || r == ',' || r == '\r'
+	}) {
+		row = strings.TrimSpace(row)
+		arrows, mines := 0, 0
+		for _, note := range row {
+			switch note {
+			case '1':
+				arrows++
+			case '2':
+				arrows++
+				stats.Holds++
+			case '4':
+				arrows++
+				stats.Rolls++
+			case 'M', 'm':
+				mines++
+			}
+		}
+		stats.Mines += mines
+		if mines > 0 {
+			stats.MineRows++
+		}
+		if arrows > 0 {
+			stats.Steps++
+		}
+		if arrows > 1 {
+			stats.Jumps++
+		}
+	}
+	return
+}
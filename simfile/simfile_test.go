@@ -0,0 +1,132 @@
+package simfile
+
+import (
+	"github.com/chris-sg/eagate_models/ddr_models"
+	"testing"
+)
+
+func TestParseSm(t *testing.T) {
+	// Setup test
+	const testFile = "./test_data/paranoia.sm"
+
+	// Run Test
+	simfile, err := ParseFile(testFile)
+	if err != nil {
+		t.Fatalf("could not parse %s: %s", testFile, err.Error())
+	}
+
+	if simfile.Title != "PARANOiA" || simfile.Artist != "180" {
+		t.Errorf("unexpected simfile header %+#v", simfile)
+	}
+	if len(simfile.Bpms) != 2 || len(simfile.Stops) != 1 {
+		t.Errorf("unexpected timing %+#v %+#v", simfile.Bpms, simfile.Stops)
+	}
+	if len(simfile.Charts) != 2 {
+		t.Fatalf("expected 2 charts, got %d", len(simfile.Charts))
+	}
+
+	single := simfile.Charts[0]
+	expectedStats := ChartStats{Steps: 5, Jumps: 1, Holds: 1, Rolls: 1, Mines: 1, MineRows: 1}
+	if single.Mode() != "SINGLE" || single.DdrDifficulty() != "EXPERT" || single.Meter != 9 || single.Stats != expectedStats {
+		t.Errorf("unexpected single chart %+#v", single)
+	}
+
+	double := simfile.Charts[1]
+	if double.Mode() != "DOUBLE" || double.DdrDifficulty() != "CHALLENGE" || double.Stats.Steps != 2 || double.Stats.Jumps != 2 {
+		t.Errorf("unexpected double chart %+#v", double)
+	}
+}
+
+func TestParseSsc(t *testing.T) {
+	// Setup test
+	const testFile = "./test_data/paranoia.ssc"
+
+	// Run Test
+	simfile, err := ParseFile(testFile)
+	if err != nil {
+		t.Fatalf("could not parse %s: %s", testFile, err.Error())
+	}
+
+	if len(simfile.Charts) != 1 {
+		t.Fatalf("expected 1 chart, got %d", len(simfile.Charts))
+	}
+	chart := simfile.Charts[0]
+	if chart.DdrDifficulty() != "DIFFICULT" || chart.Meter != 7 || chart.Stats.Steps != 2 || chart.Stats.Jumps != 1 {
+		t.Errorf("unexpected chart %+#v", chart)
+	}
+	if minBpm, maxBpm := simfile.BpmRange(chart); minBpm != 90 || maxBpm != 360 {
+		t.Errorf("expected chart bpm range 90-360, got %f-%f", minBpm, maxBpm)
+	}
+}
+
+func TestMatch(t *testing.T) {
+	// Setup test
+	simfiles, err := ParseDirectory("./test_data")
+	if err != nil {
+		t.Fatalf("could not parse test_data: %s", err.Error())
+	}
+	unknown := Simfile{Path: "unknown.sm", Title: "Unknown Song", Artist: "Nobody"}
+	simfiles = append(simfiles, unknown)
+
+	songs := []ddr_models.Song{
+		{Id: "paranoia", Name: "PARANOiA", Artist: "180"},
+	}
+	difficulties := []ddr_models.SongDifficulty{
+		{SongId: "paranoia", Mode: "SINGLE", Difficulty: "DIFFICULT", DifficultyValue: 8},
+		{SongId: "paranoia", Mode: "SINGLE", Difficulty: "EXPERT", DifficultyValue: 11},
+	}
+
+	// Run Test
+	result := Match(simfiles, songs, difficulties, nil)
+	if len(simfiles) != 2 {
+		t.Fatalf("expected the .ssc file to be preferred over the .sm file, got %d simfiles", len(simfiles))
+	}
+	if len(result.Charts) != 1 || result.Charts[0].Difficulty != "DIFFICULT" || result.Charts[0].MaxBpm != 360 {
+		t.Errorf("unexpected matched charts %+#v", result.Charts)
+	}
+	if len(result.Unmatched) != 1 || result.Unmatched[0].Key != "unknownsong|nobody" {
+		t.Fatalf("expected the unknown simfile to be unmatched, got %+#v", result.Unmatched)
+	}
+
+	manual := map[string]string{result.Unmatched[0].Key: "paranoia"}
+	result = Match(simfiles, songs, difficulties, manual)
+	if len(result.Unmatched) != 0 || result.SongIds["unknown.sm"] != "paranoia" {
+		t.Errorf("expected the manual mapping to be used, got %+#v", result)
+	}
+}
+
+func TestMatchReportsAmbiguousKeys(t *testing.T) {
+	// Setup test
+	simfiles := []Simfile{
+		{Path: "a/paranoia.sm", Title: "PARANOiA", Artist: "180"},
+		{Path: "b/paranoia.sm", Title: "ＰＡＲＡＮＯｉＡ", Artist: "180"},
+		{Path: "max.sm", Title: "MAX 300", Artist: "Ω"},
+	}
+	songs := []ddr_models.Song{
+		{Id: "paranoia", Name: "PARANOiA", Artist: "180"},
+		{Id: "max300", Name: "MAX 300", Artist: "Ω"},
+		{Id: "max300-old", Name: "MAX300", Artist: "Ω"},
+	}
+
+	// Run Test
+	result := Match(simfiles, songs, nil, nil)
+	if len(result.SongIds) != 0 || len(result.Unmatched) != 0 {
+		t.Errorf("expected no simfiles to be matched, got %+#v", result)
+	}
+	if len(result.Ambiguous) != 3 {
+		t.Fatalf("expected all three simfiles to be ambiguous, got %+#v", result.Ambiguous)
+	}
+
+	manual := map[string]string{
+		"a/paranoia.sm": "paranoia",
+		"max.sm":        "max300",
+		"paranoia|180":  "paranoia",
+	}
+	result = Match(simfiles, songs, nil, manual)
+	if result.SongIds["a/paranoia.sm"] != "paranoia" || result.SongIds["max.sm"] != "max300" {
+		t.Errorf("expected the manual path mappings to be used, got %+#v", result.SongIds)
+	}
+	if len(result.Ambiguous) != 1 || result.Ambiguous[0].Path != "b/paranoia.sm" {
+		t.Errorf("expected only b/paranoia.sm to remain ambiguous, got %+#v", result.Ambiguous)
+	}
+}
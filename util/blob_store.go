@@ -0,0 +1,83 @@
+package util
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// ErrBlobNotFound is returned by a BlobStore when no blob exists for a key.
+var ErrBlobNotFound = errors.New("blob not found")
+
+// BlobStore is a pluggable store for binary data such as images, keyed
+// by a slash separated path.
+type BlobStore interface {
+	// Get returns the blob stored for key, or ErrBlobNotFound.
+	Get(key string) ([]byte, error)
+	// Put stores data for key, replacing any existing blob.
+	Put(key string, data []byte) error
+	// Exists reports whether a blob is stored for key.
+	Exists(key string) (bool, error)
+	// Delete removes the blob for key. Deleting a missing key is not an
+	// error.
+	Delete(key string) error
+	// List returns the sorted keys that start with prefix.
+	List(prefix string) ([]string, error)
+}
+
+// MemoryBlobStore is a BlobStore held in memory, mainly useful for
+// tests and short lived processes.
+type MemoryBlobStore struct {
+	lk    sync.Mutex
+	blobs map[string][]byte
+}
+
+func NewMemoryBlobStore() *MemoryBlobStore {
+	store := new(MemoryBlobStore)
+	store.blobs = make(map[string][]byte)
+	return store
+}
+
+func (store *MemoryBlobStore) Get(key string) ([]byte, error) {
+	store.lk.Lock()
+	defer store.lk.Unlock()
+	data, ok := store.blobs[key]
+	if !ok {
+		return nil, ErrBlobNotFound
+	}
+	return data, nil
+}
+
+func (store *MemoryBlobStore) Put(key string, data []byte) error {
+	store.lk.Lock()
+	defer store.lk.Unlock()
+	store.blobs[key] = append([]byte(nil), data...)
+	return nil
+}
+
+func (store *MemoryBlobStore) Exists(key string) (bool, error) {
+	store.lk.Lock()
+	defer store.lk.Unlock()
+	_, ok := store.blobs[key]
+	return ok, nil
+}
+
+func (store *MemoryBlobStore) Delete(key string) error {
+	store.lk.Lock()
+	defer store.lk.Unlock()
+	delete(store.blobs, key)
+	return nil
+}
+
+func (store *MemoryBlobStore) List(prefix string) (keys []string, err error) {
+	store.lk.Lock()
+	defer store.lk.Unlock()
+	for key := range store.blobs {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return
+}
@@ -0,0 +1,119 @@
+package util
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// DirectoryBlobStore is a BlobStore that keeps each blob as a file below
+// a local directory, using the key as the relative path.
+type DirectoryBlobStore struct {
+	root string
+}
+
+// NewDirectoryBlobStore will create root if needed and return a store
+// using it.
+func NewDirectoryBlobStore(root string) (*DirectoryBlobStore, error) {
+	if err := os.MkdirAll(root, 0755); err != nil {
+		return nil, err
+	}
+	store := new(DirectoryBlobStore)
+	store.root = root
+	return store, nil
+}
+
+// path returns the file used for key, refusing keys that would escape
+// the root directory.
+func (store *DirectoryBlobStore) path(key string) (string, error) {
+	cleaned := filepath.Clean(filepath.FromSlash("/" + key))
+	if key == "" || cleaned == string(filepath.Separator) || strings.Contains(key, "..") {
+		return "", fmt.Errorf("invalid blob key %s", key)
+	}
+	return filepath.Join(store.root, cleaned), nil
+}
+
+func (store *DirectoryBlobStore) Get(key string) ([]byte, error) {
+	path, err := store.path(key)
+	if err != nil {
+		return nil, err
+	}
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, ErrBlobNotFound
+	}
+	return data, err
+}
+
+// Put writes data to a temporary file before renaming it into place, so
+// a blob is never left partially written.
+func (store *DirectoryBlobStore) Put(key string, data []byte) error {
+	path, err := store.path(key)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(path), ".blob-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err = tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+func (store *DirectoryBlobStore) Exists(key string) (bool, error) {
+	path, err := store.path(key)
+	if err != nil {
+		return false, err
+	}
+	_, err = os.Stat(path)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (store *DirectoryBlobStore) Delete(key string) error {
+	path, err := store.path(key)
+	if err != nil {
+		return err
+	}
+	err = os.Remove(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+func (store *DirectoryBlobStore) List(prefix string) (keys []string, err error) {
+	err = filepath.Walk(store.root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || strings.HasPrefix(info.Name(), ".blob-") {
+			return nil
+		}
+		rel, err := filepath.Rel(store.root, path)
+		if err != nil {
+			return err
+		}
+		if key := filepath.ToSlash(rel); strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+		return nil
+	})
+	sort.Strings(keys)
+	return
+}
@@ -0,0 +1,58 @@
+package util
+
+import (
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+)
+
+func TestDirectoryBlobStore(t *testing.T) {
+	// Setup test
+	dir, err := ioutil.TempDir("", "blobs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	store, err := NewDirectoryBlobStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Run Test
+	if err := store.Put("a/b/one", []byte("1")); err != nil {
+		t.Fatalf("unexpected error %s", err.Error())
+	}
+	if err := store.Put("a/two", []byte("2")); err != nil {
+		t.Fatalf("unexpected error %s", err.Error())
+	}
+	if err := store.Put("c", []byte("3")); err != nil {
+		t.Fatalf("unexpected error %s", err.Error())
+	}
+
+	if data, err := store.Get("a/b/one"); err != nil || string(data) != "1" {
+		t.Errorf("expected 1, got %s %v", data, err)
+	}
+	if _, err := store.Get("missing"); err != ErrBlobNotFound {
+		t.Errorf("expected ErrBlobNotFound, got %v", err)
+	}
+	if exists, _ := store.Exists("a/two"); !exists {
+		t.Errorf("expected a/two to exist")
+	}
+	if keys, _ := store.List("a/"); !reflect.DeepEqual(keys, []string{"a/b/one", "a/two"}) {
+		t.Errorf("unexpected keys %v", keys)
+	}
+
+	if err := store.Delete("a/two"); err != nil {
+		t.Errorf("unexpected error %s", err.Error())
+	}
+	if err := store.Delete("a/two"); err != nil {
+		t.Errorf("expected deleting a missing key to succeed, got %s", err.Error())
+	}
+	if exists, _ := store.Exists("a/two"); exists {
+		t.Errorf("expected a/two to be deleted")
+	}
+	if err := store.Put("../escape", []byte("x")); err == nil {
+		t.Errorf("expected an error for a key outside the store")
+	}
+}
@@ -88,7 +88,12 @@ func (client *EaClient) GetEaCookie() *http.Cookie {
 
 func (client *EaClient) LoginState() bool {
 	res, err := client.Client.Get("https://p.eagate.573.jp/gate/p/mypage/index.html")
-	if err != nil || res.StatusCode != 200 {
+	if err != nil {
+		glog.Warningf("loginstate for %s is false: %s\n", client.username, err.Error())
+		return false
+	}
+	res.Body.Close()
+	if res.StatusCode != 200 {
 		glog.Warningf("loginstate for %s is false, status %d\n", client.username, res.StatusCode)
 		return false
 	}
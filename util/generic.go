@@ -149,6 +149,27 @@ func GetPageContentAsGoQuery(client *http.Client, resource string) (*goquery.Doc
 	return goquery.NewDocumentFromReader(bytes.NewReader(body))
 }
 
+// GetResourceBytes will retrieve a resource using the client's
+// transport, returning the body and its content type. A non-200 status
+// is returned as an error.
+func GetResourceBytes(client *http.Client, resource string) (body []byte, contentType string, err error) {
+	glog.Infof("retrieving resource %s\n", resource)
+	res, err := client.Get(resource)
+	if err != nil {
+		glog.Errorf("failed to get resource %s: %s\n", resource, err.Error())
+		return
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		err = fmt.Errorf("unexpected status %d for resource %s", res.StatusCode, resource)
+		return
+	}
+	body, err = ioutil.ReadAll(res.Body)
+	contentType = res.Header.Get("Content-Type")
+	return
+}
+
 func BuildEaURI(resource string) string {
 	const ea = "https://p.eagate.573.jp"
 	return ea + resource
@@ -0,0 +1,162 @@
+package util
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/golang/glog"
+)
+
+// JacketImage is a song jacket along with its detected format.
+type JacketImage struct {
+	Url         string
+	Hash        string
+	ContentType string
+	Width       int
+	Height      int
+	Data        []byte
+}
+
+// JacketCache fetches jackets through an EaClient and keeps them in a
+// BlobStore under a key prefix. Jackets are stored once per content
+// hash, and each URL is only fetched by one caller at a time.
+type JacketCache struct {
+	client    EaClient
+	store     BlobStore
+	keyPrefix string
+
+	lk        sync.Mutex
+	urlHashes map[string]string
+	inflight  map[string]*sync.WaitGroup
+}
+
+// NewJacketCache returns a JacketCache storing jackets in store under
+// keyPrefix, such as "ddr/jackets/".
+func NewJacketCache(client EaClient, store BlobStore, keyPrefix string) *JacketCache {
+	cache := new(JacketCache)
+	cache.client = client
+	cache.store = store
+	cache.keyPrefix = keyPrefix
+	cache.urlHashes = make(map[string]string)
+	cache.inflight = make(map[string]*sync.WaitGroup)
+	return cache
+}
+
+// Jacket returns the jacket at jacketUrl, from the store if it has been
+// fetched before. Only one lookup or fetch of a URL runs at a time, so a
+// jacket missing from the store is fetched again only once.
+func (cache *JacketCache) Jacket(jacketUrl string) (jacket JacketImage, err error) {
+	cache.lk.Lock()
+	for {
+		wg, fetching := cache.inflight[jacketUrl]
+		if !fetching {
+			break
+		}
+		cache.lk.Unlock()
+		wg.Wait()
+		cache.lk.Lock()
+	}
+	hash, known := cache.urlHashes[jacketUrl]
+	wg := new(sync.WaitGroup)
+	wg.Add(1)
+	cache.inflight[jacketUrl] = wg
+	defer func() {
+		cache.lk.Lock()
+		delete(cache.inflight, jacketUrl)
+		cache.lk.Unlock()
+		wg.Done()
+	}()
+	cache.lk.Unlock()
+
+	if known {
+		data, err := cache.store.Get(JacketBlobKey(cache.keyPrefix, hash))
+		if err == nil {
+			return jacketImageFromBytes(jacketUrl, data, ""), nil
+		}
+		glog.Warningf("jacket %s missing from store, fetching again: %s\n", jacketUrl, err.Error())
+	} else if data, err := cache.store.Get(cache.urlBlobKey(jacketUrl)); err == nil {
+		hash = string(data)
+		if data, err := cache.store.Get(JacketBlobKey(cache.keyPrefix, hash)); err == nil {
+			cache.rememberUrl(jacketUrl, hash)
+			return jacketImageFromBytes(jacketUrl, data, ""), nil
+		}
+	}
+
+	data, contentType, err := GetResourceBytes(cache.client.Client, jacketUrl)
+	if err != nil {
+		return
+	}
+	jacket = jacketImageFromBytes(jacketUrl, data, contentType)
+
+	exists, err := cache.store.Exists(JacketBlobKey(cache.keyPrefix, jacket.Hash))
+	if err != nil {
+		return
+	}
+	if !exists {
+		if err = cache.store.Put(JacketBlobKey(cache.keyPrefix, jacket.Hash), data); err != nil {
+			return
+		}
+	}
+	if err = cache.store.Put(cache.urlBlobKey(jacketUrl), []byte(jacket.Hash)); err != nil {
+		return
+	}
+	cache.rememberUrl(jacketUrl, jacket.Hash)
+	return
+}
+
+// JacketForHash returns a jacket that has already been fetched into the
+// store, by the hash recorded in JacketImage.Hash.
+func (cache *JacketCache) JacketForHash(hash string) (jacket JacketImage, err error) {
+	data, err := cache.store.Get(JacketBlobKey(cache.keyPrefix, hash))
+	if err != nil {
+		return
+	}
+	jacket = jacketImageFromBytes("", data, "")
+	return
+}
+
+func (cache *JacketCache) rememberUrl(jacketUrl string, hash string) {
+	cache.lk.Lock()
+	defer cache.lk.Unlock()
+	cache.urlHashes[jacketUrl] = hash
+}
+
+func (cache *JacketCache) urlBlobKey(jacketUrl string) string {
+	sum := sha256.Sum256([]byte(jacketUrl))
+	return cache.keyPrefix + "url/" + hex.EncodeToString(sum[:])
+}
+
+// JacketBlobKey returns the key a JacketCache with keyPrefix stores the
+// jacket with the given hash under.
+func JacketBlobKey(keyPrefix string, hash string) string {
+	return keyPrefix + hash
+}
+
+// jacketImageFromBytes builds a JacketImage, detecting the content type
+// from the data when the server did not provide a usable one.
+func jacketImageFromBytes(jacketUrl string, data []byte, contentType string) (jacket JacketImage) {
+	sum := sha256.Sum256(data)
+	jacket.Url = jacketUrl
+	jacket.Hash = hex.EncodeToString(sum[:])
+	jacket.Data = data
+
+	config, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err == nil {
+		jacket.Width = config.Width
+		jacket.Height = config.Height
+		contentType = "image/" + format
+	}
+	if contentType == "" || strings.HasPrefix(contentType, "text/") {
+		contentType = http.DetectContentType(data)
+	}
+	jacket.ContentType = contentType
+	return
+}
@@ -0,0 +1,72 @@
+package util
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// countingBlobStore counts the puts for each key, with slow puts so that
+// concurrent lookups overlap a fetch.
+type countingBlobStore struct {
+	*MemoryBlobStore
+
+	lk   sync.Mutex
+	puts map[string]int
+}
+
+func (store *countingBlobStore) Put(key string, data []byte) error {
+	time.Sleep(10 * time.Millisecond)
+	store.lk.Lock()
+	store.puts[key]++
+	store.lk.Unlock()
+	return store.MemoryBlobStore.Put(key, data)
+}
+
+func TestJacketCacheRefetchesMissingJacketOnce(t *testing.T) {
+	// Setup test
+	const jacketUri = "https://p.eagate.573.jp/game/ddr/ddra20/p/images/binary_jk.html?img=1PoOQPd0D01Q9O0doiQQQ8D8Q096bDq9&kind=1"
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	}))
+	defer ts.Close()
+	client := GenerateClient()
+	client.SetTestClient(ts, map[string]string{
+		jacketUri: "../ddr/test_data/jacket/1PoOQPd0D01Q9O0doiQQQ8D8Q096bDq9.jpg",
+	})
+	store := &countingBlobStore{MemoryBlobStore: NewMemoryBlobStore(), puts: make(map[string]int)}
+	cache := NewJacketCache(client, store, "test/jackets/")
+
+	jacket, err := cache.Jacket(jacketUri)
+	if err != nil {
+		t.Fatalf("failed to load jacket: %s", err.Error())
+	}
+	if jacket.ContentType != "image/jpeg" || jacket.Width == 0 || jacket.Height == 0 {
+		t.Errorf("unexpected jacket %s %dx%d", jacket.ContentType, jacket.Width, jacket.Height)
+	}
+	if err := store.Delete(JacketBlobKey("test/jackets/", jacket.Hash)); err != nil {
+		t.Fatal(err)
+	}
+	store.puts = make(map[string]int)
+
+	// Run Test
+	wg := new(sync.WaitGroup)
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := cache.Jacket(jacketUri); err != nil {
+				t.Errorf("failed to load jacket: %s", err.Error())
+			}
+		}()
+	}
+	wg.Wait()
+
+	if puts := store.puts[cache.urlBlobKey(jacketUri)]; puts != 1 {
+		t.Errorf("expected the missing jacket to be fetched once, got %d fetches", puts)
+	}
+	if _, err := cache.JacketForHash(jacket.Hash); err != nil {
+		t.Errorf("expected the jacket to be stored again: %s", err.Error())
+	}
+}